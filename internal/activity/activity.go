@@ -0,0 +1,233 @@
+// Package activity is an append-only JSONL log of notable things that
+// happen across FauxFoundry - spec loads/saves, validations, generation
+// start/finish, model pulls - distinct from internal/history's Bolt-backed
+// Run records, which exist to support branching a new generation off a
+// prior spec rather than to narrate "what just happened." Recorder is what
+// a subsystem appends an Entry through; Load/Watch are how a reader (the
+// TUI's "Recent Activity" section) gets the existing backlog plus live
+// updates as other fauxfoundry invocations append to the same file.
+package activity
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Entry is a single recorded activity line, stored as one JSON object per
+// line. Time is RFC3339 on disk (time.Time's default JSON encoding).
+type Entry struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/fauxfoundry/activity.log -
+// os.UserConfigDir already falls back to $HOME/.config when
+// XDG_CONFIG_HOME is unset - or "" if neither can be resolved.
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "fauxfoundry", "activity.log")
+}
+
+// Recorder appends Entries to a JSONL file at Path, creating it (and its
+// parent directory) on first use. It keeps no file handle open between
+// calls, since several fauxfoundry processes may be appending to the same
+// log at once.
+type Recorder struct {
+	Path string
+}
+
+// NewRecorder builds a Recorder for path, or DefaultPath() if path is
+// empty.
+func NewRecorder(path string) *Recorder {
+	if path == "" {
+		path = DefaultPath()
+	}
+	return &Recorder{Path: path}
+}
+
+// Record appends one Entry for action (plus an optional detail), stamped
+// with the current time. Most callers treat a failure here the same way
+// internal/cli's recordHistory treats a history-store failure: worth a
+// warning, never worth failing the operation it's describing.
+func (r *Recorder) Record(action, detail string) error {
+	if r.Path == "" {
+		return fmt.Errorf("activity: no path to record to (no home or config directory)")
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return fmt.Errorf("failed to create activity log directory: %w", err)
+	}
+
+	f, err := os.OpenFile(r.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open activity log: %w", err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(Entry{Time: time.Now().UTC(), Action: action, Detail: detail})
+	if err != nil {
+		return fmt.Errorf("failed to marshal activity entry: %w", err)
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// Load reads every Entry currently in path, oldest first. A missing file
+// is treated as an empty log rather than an error, since nothing may have
+// been recorded yet.
+func Load(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open activity log: %w", err)
+	}
+	defer f.Close()
+
+	return decodeEntries(f)
+}
+
+// decodeEntries scans r line by line, skipping blank lines and any line
+// that fails to decode (a log torn mid-write by a concurrent Record is
+// more useful with the rest of the file intact than rejected outright).
+func decodeEntries(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// Watcher tails a log file with fsnotify, emitting each Entry appended
+// after it starts watching - by this process's own Recorder or another
+// fauxfoundry invocation - on Entries, until Close is called.
+type Watcher struct {
+	watcher *fsnotify.Watcher
+	path    string
+	offset  int64
+
+	Entries chan Entry
+	Errors  chan error
+}
+
+// Watch starts tailing path from its current size, creating the file (and
+// its parent directory) first if needed so fsnotify has something to
+// watch. It watches path's containing directory rather than the file
+// itself, since some writers replace a file rather than appending to it in
+// place, which a file-level watch would silently stop following.
+func Watch(path string) (*Watcher, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create activity log directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open activity log: %w", err)
+	}
+	var offset int64
+	if info, statErr := f.Stat(); statErr == nil {
+		offset = info.Size()
+	}
+	f.Close()
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start activity log watcher: %w", err)
+	}
+	if err := fsw.Add(filepath.Dir(path)); err != nil {
+		fsw.Close()
+		return nil, fmt.Errorf("failed to watch activity log directory: %w", err)
+	}
+
+	w := &Watcher{
+		watcher: fsw,
+		path:    path,
+		offset:  offset,
+		Entries: make(chan Entry, 16),
+		Errors:  make(chan error, 1),
+	}
+	go w.run()
+	return w, nil
+}
+
+// run drains fsnotify events until the watcher is closed, pulling any
+// newly-appended Entries into Entries each time path changes.
+func (w *Watcher) run() {
+	defer close(w.Entries)
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(w.path) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			w.drain()
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			select {
+			case w.Errors <- err:
+			default:
+			}
+		}
+	}
+}
+
+// drain reads every Entry appended since the last read offset and sends
+// each on Entries, dropping any that arrive while a slow consumer is still
+// catching up rather than blocking the watch loop.
+func (w *Watcher) drain() {
+	f, err := os.Open(w.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(w.offset, io.SeekStart); err != nil {
+		return
+	}
+	entries, err := decodeEntries(f)
+	if err != nil {
+		return
+	}
+	if info, statErr := f.Stat(); statErr == nil {
+		w.offset = info.Size()
+	}
+	for _, e := range entries {
+		select {
+		case w.Entries <- e:
+		default:
+		}
+	}
+}
+
+// Close stops watching and releases the underlying fsnotify.Watcher.
+func (w *Watcher) Close() error {
+	return w.watcher.Close()
+}
@@ -0,0 +1,104 @@
+// Package agents implements declarative per-field tool overrides: instead
+// of leaving a field entirely up to the model, a Field.Tools entry names a
+// Tool (lookup_reference, regex_sample, faker, sql_query) that derives its
+// value deterministically. Executor runs as a local post-processing pass
+// after a batch finishes generating, unconditionally overwriting every
+// tooled field on every record with its tool's result.
+//
+// This is not model-driven tool-calling: the model never sees a field's
+// Tools entries, never decides whether or with what arguments to invoke
+// one, and nothing is fed back into its generation turn. A field with
+// Tools set always has its model-generated value discarded and replaced,
+// on every record, regardless of what (if anything) the model produced
+// for it.
+package agents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Tool is a single named capability an Executor can dispatch a field's
+// tool call to. Args decodes the same loosely-typed way the rest of a
+// spec does (Field.Default, Dataset.Schema): numeric values arrive as
+// float64, nested structures as map[string]interface{}.
+type Tool interface {
+	// Name is the registry key a Field.Tools entry's name must match.
+	Name() string
+	// Execute runs the tool against args, returning the value to store in
+	// the record (any JSON-marshalable value: string, number, bool, map,
+	// slice).
+	Execute(ctx context.Context, args map[string]interface{}) (interface{}, error)
+}
+
+// Executor holds the registered tools available to a generation run and
+// applies them to generated records. The zero value is not usable; build
+// one with NewExecutor.
+type Executor struct {
+	tools  map[string]Tool
+	logger log.Logger
+}
+
+// NewExecutor builds an Executor with the builtin tools (regex_sample,
+// faker, lookup_reference backed by referenceDir, sql_query) registered.
+// referenceDir may be empty, in which case lookup_reference fails with a
+// clear error instead of silently returning nothing.
+func NewExecutor(logger log.Logger, referenceDir string) *Executor {
+	e := &Executor{
+		tools:  make(map[string]Tool),
+		logger: logger,
+	}
+
+	e.Register(regexSampleTool{})
+	e.Register(fakerTool{})
+	e.Register(newLookupReferenceTool(referenceDir))
+	e.Register(sqlQueryTool{})
+
+	return e
+}
+
+// Register adds tool under its Name, replacing any existing tool
+// registered under the same name.
+func (e *Executor) Register(tool Tool) {
+	e.tools[tool.Name()] = tool
+}
+
+// Execute dispatches to the tool registered as name.
+func (e *Executor) Execute(ctx context.Context, name string, args map[string]interface{}) (interface{}, error) {
+	tool, ok := e.tools[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown tool %q", name)
+	}
+	return tool.Execute(ctx, args)
+}
+
+// ApplyToRecord runs every Tools entry across fields against record,
+// overwriting record[field.Name] with each tool's result in order (a
+// field with more than one tool keeps only the last one's result). A
+// field with no Tools entries is left exactly as generation produced it.
+func (e *Executor) ApplyToRecord(ctx context.Context, fields []types.Field, record types.Record) error {
+	for _, field := range fields {
+		for _, tool := range field.Tools {
+			result, err := e.Execute(ctx, tool.Name, tool.Args)
+			if err != nil {
+				return fmt.Errorf("field %q tool %q: %w", field.Name, tool.Name, err)
+			}
+			record[field.Name] = result
+		}
+	}
+	return nil
+}
+
+// ApplyToRecords runs ApplyToRecord across every record in records,
+// stopping at the first error.
+func (e *Executor) ApplyToRecords(ctx context.Context, fields []types.Field, records []types.Record) error {
+	for _, record := range records {
+		if err := e.ApplyToRecord(ctx, fields, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
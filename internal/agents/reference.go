@@ -0,0 +1,83 @@
+package agents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// lookupReferenceTool implements lookup_reference(table, key): table
+// names a JSON file under dir (table.json), holding an object mapping
+// keys to arbitrary values; key selects one entry from it. Tables are
+// loaded lazily on first use and cached for the Executor's lifetime, so a
+// spec generating many records against the same table only pays the read
+// once.
+type lookupReferenceTool struct {
+	dir string
+
+	mu     sync.Mutex
+	tables map[string]map[string]interface{}
+}
+
+func newLookupReferenceTool(dir string) *lookupReferenceTool {
+	return &lookupReferenceTool{
+		dir:    dir,
+		tables: make(map[string]map[string]interface{}),
+	}
+}
+
+func (*lookupReferenceTool) Name() string { return "lookup_reference" }
+
+func (t *lookupReferenceTool) Execute(_ context.Context, args map[string]interface{}) (interface{}, error) {
+	table, err := argString(args, "table")
+	if err != nil {
+		return nil, err
+	}
+	key, err := argString(args, "key")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.loadTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return nil, fmt.Errorf("lookup_reference: key %q not found in table %q", key, table)
+	}
+	return value, nil
+}
+
+// loadTable returns table's contents, loading and caching it from
+// <dir>/<table>.json on first use.
+func (t *lookupReferenceTool) loadTable(table string) (map[string]interface{}, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if data, ok := t.tables[table]; ok {
+		return data, nil
+	}
+
+	if t.dir == "" {
+		return nil, fmt.Errorf("lookup_reference: no reference directory configured (table %q requested)", table)
+	}
+
+	path := filepath.Join(t.dir, table+".json")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("lookup_reference: reading table %q: %w", table, err)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("lookup_reference: parsing table %q: %w", table, err)
+	}
+
+	t.tables[table] = data
+	return data, nil
+}
@@ -0,0 +1,166 @@
+package agents
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	illm "github.com/copyleftdev/faux-foundry/internal/llm"
+)
+
+// argString extracts a required string argument named key from args.
+func argString(args map[string]interface{}, key string) (string, error) {
+	v, ok := args[key]
+	if !ok {
+		return "", fmt.Errorf("missing required arg %q", key)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("arg %q must be a string, got %T", key, v)
+	}
+	return s, nil
+}
+
+// regexSampleTool implements the regex_sample(pattern) tool: it reuses the
+// same regex-AST-driven synthesis Field.Pattern fields already use
+// (internal/llm.GeneratePatternString), so a field can pull a patterned
+// value outside of what the model is asked to invent.
+type regexSampleTool struct{}
+
+// seed is shared across all regexSampleTool values (there's only ever one,
+// registered once per Executor) so repeated calls within a run don't all
+// synthesize the same string for the same pattern.
+var regexSampleSeed int64
+
+func (regexSampleTool) Name() string { return "regex_sample" }
+
+func (regexSampleTool) Execute(_ context.Context, args map[string]interface{}) (interface{}, error) {
+	pattern, err := argString(args, "pattern")
+	if err != nil {
+		return nil, err
+	}
+	seed := int(atomic.AddInt64(&regexSampleSeed, 1))
+	return illm.GeneratePatternString(pattern, seed), nil
+}
+
+// fakerFirstNames/fakerLastNames mirror the small sample pools
+// OllamaClient.generateDemoData already draws from, kept in sync so a
+// faker-tooled field and a no-LLM demo run produce data from the same
+// style of pool rather than two unrelated-looking name lists.
+var (
+	fakerFirstNames = []string{"John", "Jane", "Michael", "Sarah", "David", "Lisa", "Robert", "Emily", "James", "Ashley"}
+	fakerLastNames  = []string{"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis", "Rodriguez", "Martinez"}
+)
+
+// fakerSeed is shared across all fakerTool values for the same reason
+// regexSampleSeed is.
+var fakerSeed int64
+
+// fakerTool implements faker(locale, type): a small, deterministic stand-in
+// for a real faker library, covering the field types realistic demo data
+// generation already needs. Only locale "en" is supported; any other
+// locale is a clear error rather than a silent fallback to English.
+type fakerTool struct{}
+
+func (fakerTool) Name() string { return "faker" }
+
+func (fakerTool) Execute(_ context.Context, args map[string]interface{}) (interface{}, error) {
+	locale, err := argString(args, "locale")
+	if err != nil {
+		return nil, err
+	}
+	if locale != "en" {
+		return nil, fmt.Errorf("unsupported faker locale %q (only \"en\" is supported)", locale)
+	}
+
+	fakerType, err := argString(args, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	i := int(atomic.AddInt64(&fakerSeed, 1))
+	first := fakerFirstNames[i%len(fakerFirstNames)]
+	last := fakerLastNames[(i/len(fakerFirstNames))%len(fakerLastNames)]
+
+	switch fakerType {
+	case "first_name":
+		return first, nil
+	case "last_name":
+		return last, nil
+	case "name":
+		return first + " " + last, nil
+	case "email":
+		return fmt.Sprintf("%s.%s@example.com", first, last), nil
+	case "phone":
+		return fmt.Sprintf("(%03d) %03d-%04d", 200+i%800, 100+i%900, 1000+i%9000), nil
+	case "company":
+		return fmt.Sprintf("%s %s Inc.", last, []string{"Global", "Dynamics", "Solutions", "Partners"}[i%4]), nil
+	default:
+		return nil, fmt.Errorf("unsupported faker type %q", fakerType)
+	}
+}
+
+// sqlQueryTool implements sql_query(driver, dsn, query): it goes through
+// database/sql's driver registry exactly like any other Go program would,
+// so it works with whatever driver a deployment blank-imports (e.g.
+// lib/pq, go-sql-driver/mysql) without this package depending on one
+// itself. Query is expected to return exactly one row; its columns become
+// the returned map's keys.
+type sqlQueryTool struct{}
+
+func (sqlQueryTool) Name() string { return "sql_query" }
+
+func (sqlQueryTool) Execute(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dsn, err := argString(args, "dsn")
+	if err != nil {
+		return nil, err
+	}
+	query, err := argString(args, "query")
+	if err != nil {
+		return nil, err
+	}
+	driver, err := argString(args, "driver")
+	if err != nil {
+		return nil, fmt.Errorf(`missing required arg "driver" (e.g. "postgres", "mysql" — whichever database/sql driver the deployment blank-imports): %w`, err)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql_query: open %s: %w (is the driver blank-imported somewhere in this build?)", driver, err)
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("sql_query: query: %w", err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("sql_query: columns: %w", err)
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("sql_query: %w", err)
+		}
+		return nil, fmt.Errorf("sql_query: query returned no rows")
+	}
+
+	values := make([]interface{}, len(cols))
+	scanTargets := make([]interface{}, len(cols))
+	for i := range values {
+		scanTargets[i] = &values[i]
+	}
+	if err := rows.Scan(scanTargets...); err != nil {
+		return nil, fmt.Errorf("sql_query: scan: %w", err)
+	}
+
+	result := make(map[string]interface{}, len(cols))
+	for i, col := range cols {
+		result[col] = values[i]
+	}
+	return result, nil
+}
@@ -0,0 +1,13 @@
+// Package buildinfo holds the version and commit identifying the running
+// fauxfoundry binary, so the CLI's --version output and the TUI's banner
+// always agree instead of each hardcoding their own string.
+package buildinfo
+
+// Version and Commit default to placeholders for `go run`/`go test`/a
+// plain `go build`. A release build sets both via:
+//
+//	go build -ldflags "-X .../internal/buildinfo.Version=v1.2.3 -X .../internal/buildinfo.Commit=$(git rev-parse --short HEAD)"
+var (
+	Version = "dev"
+	Commit  = "unknown"
+)
@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+	"github.com/copyleftdev/faux-foundry/internal/buildinfo"
+	"github.com/copyleftdev/faux-foundry/internal/cliconfig"
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+	"github.com/copyleftdev/faux-foundry/internal/termcache"
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/metrics"
+)
+
+// Config holds the global flags every subcommand used to read from package
+// variables (cfgFile, verbose, quiet, noColor), plus the layered model
+// configuration resolved by initConfig.
+type Config struct {
+	CfgFile string
+	Verbose bool
+	Quiet   bool
+	NoColor bool
+	Profile string
+
+	// NoTermCache disables internal/termcache's cached terminal
+	// color-profile/background detection, forcing a live probe on every
+	// run - the escape hatch for a terminal whose capabilities changed
+	// since the cache was written.
+	NoTermCache bool
+
+	// LogFormat selects pkg/log's output encoding ("console" or "json");
+	// LogLevel is a slog level name ("debug", "info", "warn", "error");
+	// LogFile redirects logging from Stderr to a named file, leaving
+	// Stderr free for command output that isn't a log record.
+	LogFormat string
+	LogLevel  string
+	LogFile   string
+
+	// ReferenceDir is the directory internal/agents' lookup_reference tool
+	// reads its "<table>.json" files from. Empty disables lookup_reference
+	// (it returns a clear error instead of silently resolving nothing).
+	ReferenceDir string
+
+	// HistoryDB is the Bolt file internal/history persists every
+	// generation run to, consulted by `history list`/`history branch` and
+	// written to by every `generate` run.
+	HistoryDB string
+
+	// ModelFlags mirror the --model-* persistent flags; their zero values
+	// mean "not set", which is what lets cliconfig.Load tell an explicit
+	// flag apart from an unset one via cmd.Flags().Changed.
+	ModelEndpoint    string
+	ModelName        string
+	ModelBatchSize   int
+	ModelTemperature float64
+	ModelTimeout     string
+	ModelProvider    string
+
+	// Resolved is the layered model configuration (flag > env > profile >
+	// config file > built-in) computed by initConfig.
+	Resolved *cliconfig.Model
+}
+
+// IsQuiet reports whether --quiet was set. It exists alongside the Quiet
+// field mainly so initLogging's LevelForQuiet call reads as intent rather
+// than a raw bool plumb-through.
+func (c Config) IsQuiet() bool {
+	return c.Quiet
+}
+
+// App bundles every dependency a subcommand needs: its Config, stdio, a
+// logger, and the LLM client. Subcommand constructors (newValidateCmd,
+// newDoctorCmd, ...) close over an *App instead of reading package globals,
+// which is what lets NewTestApp substitute fakes and run commands in
+// parallel without racing on process-level state.
+type App struct {
+	Config Config
+	Stdout io.Writer
+	Stderr io.Writer
+	Stdin  io.Reader
+	Logger log.Logger
+	LLM    llm.Client
+
+	// logMasker sits between Logger and its underlying writer so
+	// MaskSecret can redact a value (e.g. a credential read from the
+	// model config) from every log line written from here on.
+	logMasker *log.Masker
+
+	// Metrics holds the Prometheus registry `generate` records batch and
+	// record counts into, and `serve-metrics` exposes over HTTP. NewApp
+	// always sets it; NewTestApp leaves it nil, which every
+	// metrics.Registry method tolerates as a no-op.
+	Metrics *metrics.Registry
+
+	// Activity is the same JSONL log (internal/activity) the TUI's Recent
+	// Activity section tails, so a plain CLI run of `generate`/`validate`
+	// shows up there too. NewApp always sets it; NewTestApp leaves it nil,
+	// which recordActivity tolerates as a no-op.
+	Activity *activity.Recorder
+}
+
+// NewApp builds the production App: real stdio, a logger writing to
+// stderr, and a live Ollama client. initLogging replaces Logger with one
+// honoring --log-format/--log-level/--log-file once flags are parsed; this
+// one only has to be good enough for anything that logs before then.
+func NewApp() *App {
+	masker := log.NewMasker(os.Stderr)
+	logger := log.New(log.FormatConsole, slog.LevelInfo, masker)
+
+	reg := metrics.NewRegistry()
+	client := llm.NewProviderClient()
+	client.Metrics = reg
+	client.Logger = logger
+
+	return &App{
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+		Stdin:     os.Stdin,
+		Logger:    logger,
+		logMasker: masker,
+		LLM:       client,
+		Metrics:   reg,
+		Activity:  activity.NewRecorder(""),
+	}
+}
+
+// NewTestApp builds an App suited to tests: Stdout/Stderr are captured
+// in-memory buffers instead of the real terminal, the logger is silenced,
+// and LLM is nil — callers that exercise a command needing it should set
+// app.LLM to a fake before calling Run.
+func NewTestApp(t testing.TB) *App {
+	t.Helper()
+
+	masker := log.NewMasker(io.Discard)
+
+	return &App{
+		Stdout:    &bytes.Buffer{},
+		Stderr:    &bytes.Buffer{},
+		Stdin:     &bytes.Buffer{},
+		Logger:    log.New(log.FormatConsole, slog.LevelError, masker),
+		logMasker: masker,
+	}
+}
+
+// MaskSecret registers secret for redaction from every log line Logger
+// writes from here on.
+func (a *App) MaskSecret(secret string) {
+	a.logMasker.AddMask(secret)
+}
+
+// recordActivity appends one line to the shared internal/activity log, if
+// Activity is set (NewTestApp leaves it nil). Like recordHistory, a
+// failure here only gets a warning - losing an activity line should never
+// fail the command it's describing.
+func (a *App) recordActivity(action, detail string) {
+	if a.Activity == nil {
+		return
+	}
+	if err := a.Activity.Record(action, detail); err != nil {
+		a.Logger.Warn("failed to record activity", "action", action, "error", err)
+	}
+}
+
+// rootCmd builds a fresh root *cobra.Command wired to a, with every
+// subcommand registered. It's built fresh per App (rather than once at
+// package init) so parallel tests each get an independent command tree.
+func (a *App) rootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "fauxfoundry",
+		Short: "A CLI and TUI for synthetic, domain-aware data generation powered by local LLMs",
+		Long: `FauxFoundry enables teams to generate unique synthetic datasets from human-readable
+YAML specifications. It leverages local AI models (e.g., Ollama) to produce realistic,
+domain-aware data that respects schema constraints while ensuring exactly N unique records
+are delivered through efficient streaming with minimal validation overhead.
+
+This tool is designed with system empathy: it maintains constant memory usage, degrades
+gracefully under errors, and provides both automation-friendly CLI commands and discoverable
+TUI workflows for different user needs.`,
+		Version:       buildinfo.Version,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	root.PersistentFlags().StringVar(&a.Config.CfgFile, "config", "", "config file (default is $HOME/.fauxfoundry.yaml)")
+	root.PersistentFlags().BoolVarP(&a.Config.Verbose, "verbose", "v", false, "enable verbose logging")
+	root.PersistentFlags().BoolVarP(&a.Config.Quiet, "quiet", "q", false, "suppress non-essential output")
+	root.PersistentFlags().BoolVar(&a.Config.NoColor, "no-color", false, "disable colored output")
+	root.PersistentFlags().BoolVar(&a.Config.NoTermCache, "no-term-cache", false, "skip the cached terminal color-profile probe and always detect it live")
+	root.PersistentFlags().StringVar(&a.Config.Profile, "profile", "", "named config profile to use (default: $FAUXFOUNDRY_PROFILE)")
+	root.PersistentFlags().StringVar(&a.Config.ModelEndpoint, "model-endpoint", "", "Ollama endpoint (default: $FAUXFOUNDRY_MODEL_ENDPOINT)")
+	root.PersistentFlags().StringVar(&a.Config.ModelName, "model-name", "", "model name (default: $FAUXFOUNDRY_MODEL_NAME)")
+	root.PersistentFlags().IntVar(&a.Config.ModelBatchSize, "model-batch-size", 0, "model batch size (default: $FAUXFOUNDRY_MODEL_BATCH_SIZE)")
+	root.PersistentFlags().Float64Var(&a.Config.ModelTemperature, "model-temperature", 0, "model temperature (default: $FAUXFOUNDRY_MODEL_TEMPERATURE)")
+	root.PersistentFlags().StringVar(&a.Config.ModelTimeout, "model-timeout", "", "model timeout (default: $FAUXFOUNDRY_MODEL_TIMEOUT)")
+	root.PersistentFlags().StringVar(&a.Config.ModelProvider, "model-provider", "", "LLM provider: ollama, openai, openai-compatible, anthropic, or google (default: $FAUXFOUNDRY_MODEL_PROVIDER)")
+	root.PersistentFlags().StringVar(&a.Config.ReferenceDir, "reference-dir", "", "directory of <table>.json files the lookup_reference field tool reads from")
+	root.PersistentFlags().StringVar(&a.Config.HistoryDB, "history-db", defaultHistoryDBPath(), "path to the generation run history store")
+	root.PersistentFlags().StringVar(&a.Config.LogFormat, "log-format", "console", "log output format: console or json")
+	root.PersistentFlags().StringVar(&a.Config.LogLevel, "log-level", "info", "log level: debug, info, warn, or error")
+	root.PersistentFlags().StringVar(&a.Config.LogFile, "log-file", "", "write logs to this file instead of stderr")
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// Runs before initLogging/initConfig, and before any subcommand
+		// can render styled output, so the cached (or freshly detected)
+		// color profile is already in effect by the time anything uses it.
+		termcache.Apply(a.Config.NoTermCache)
+		if err := a.initLogging(); err != nil {
+			return err
+		}
+		return a.initConfig(cmd)
+	}
+
+	root.AddCommand(newGenerateCmd(a))
+	root.AddCommand(newValidateCmd(a))
+	root.AddCommand(newInitCmd(a))
+	root.AddCommand(newTUICmd(a))
+	root.AddCommand(newDoctorCmd(a))
+	root.AddCommand(newSchemaCmd(a))
+	root.AddCommand(newServeMetricsCmd(a))
+	root.AddCommand(newHistoryCmd(a))
+
+	return root
+}
+
+// initLogging replaces a.Logger with one built from the now-parsed
+// --log-format/--log-level/--log-file flags. It runs before initConfig in
+// PersistentPreRunE so that config resolution — and anything a subcommand
+// logs afterward — uses the requested format, level, and destination
+// instead of NewApp's stderr/console/info defaults.
+func (a *App) initLogging() error {
+	w := io.Writer(a.Stderr)
+	if a.Config.LogFile != "" {
+		f, err := os.OpenFile(a.Config.LogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		w = f
+	}
+
+	masker := log.NewMasker(w)
+	level := log.LevelForQuiet(a.Config.IsQuiet(), a.Config.LogLevel)
+	a.Logger = log.New(log.Format(a.Config.LogFormat), level, masker)
+	a.logMasker = masker
+
+	if client, ok := a.LLM.(*llm.ProviderClient); ok {
+		client.Logger = a.Logger
+	}
+	return nil
+}
+
+// initConfig resolves the layered model configuration (flags, FAUXFOUNDRY_*
+// env vars, the selected profile, and .fauxfoundry.yaml config files) via
+// cliconfig.Load, stores it on a.Config.Resolved, and applies it as the
+// fallback defaults spec.setDefaults uses for any field a spec leaves unset.
+func (a *App) initConfig(cmd *cobra.Command) error {
+	resolved, err := cliconfig.Load(cliconfig.Options{
+		CfgFile: a.Config.CfgFile,
+		Profile: a.Config.Profile,
+		Flags:   cmd,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve configuration: %w", err)
+	}
+
+	a.Config.Resolved = resolved
+	spec.SetModelDefaults(spec.ModelDefaults{
+		Endpoint:    resolved.Endpoint,
+		Name:        resolved.Name,
+		BatchSize:   resolved.BatchSize,
+		Temperature: resolved.Temperature,
+		Timeout:     resolved.Timeout,
+		Provider:    resolved.Provider,
+	})
+	return nil
+}
+
+// Run builds the root command wired to a and executes it against args,
+// directing output to a.Stdout/a.Stderr instead of the process's. It
+// installs an OTLP tracer provider first (a no-op unless
+// OTEL_EXPORTER_OTLP_ENDPOINT is set) so every generate batch's span has
+// somewhere to go, and flushes it once the command finishes.
+func (a *App) Run(args []string) error {
+	ctx := context.Background()
+	shutdownTracing, err := metrics.InitTracing(ctx, "fauxfoundry")
+	if err != nil {
+		a.Logger.Warn("failed to initialize OTLP tracing", "error", err)
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+	defer func() {
+		if err := shutdownTracing(ctx); err != nil {
+			a.Logger.Warn("failed to flush OTLP traces", "error", err)
+		}
+	}()
+
+	cmd := a.rootCmd()
+	cmd.SetOut(a.Stdout)
+	cmd.SetErr(a.Stderr)
+	cmd.SetIn(a.Stdin)
+	cmd.SetArgs(args)
+	return cmd.Execute()
+}
+
+// Execute builds the root command wired to app and runs it with the
+// process's real command-line arguments. A main package should call this
+// once, after building app with NewApp.
+func Execute(app *App) error {
+	return app.Run(os.Args[1:])
+}
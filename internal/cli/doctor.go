@@ -7,14 +7,19 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
 	"github.com/copyleftdev/faux-foundry/internal/llm"
 )
 
-// doctorCmd represents the doctor command for system health checks
-var doctorCmd = &cobra.Command{
-	Use:   "doctor",
-	Short: "Check system health and Ollama setup",
-	Long: `Run comprehensive health checks to ensure FauxFoundry is properly configured.
+// newDoctorCmd builds the doctor subcommand, closing over app instead of
+// reading package globals and instantiating its own Ollama client.
+func newDoctorCmd(app *App) *cobra.Command {
+	var doctorEndpoint string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check system health and Ollama setup",
+		Long: `Run comprehensive health checks to ensure FauxFoundry is properly configured.
 This command checks:
   - Ollama installation and connectivity
   - Available models
@@ -27,81 +32,88 @@ Examples:
 
   # Check specific endpoint
   fauxfoundry doctor --endpoint http://localhost:11434`,
-	RunE: runDoctor,
-}
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runDoctor(doctorEndpoint)
+		},
+	}
 
-var (
-	doctorEndpoint string
-)
+	cmd.Flags().StringVar(&doctorEndpoint, "endpoint", "http://localhost:11434", "Ollama endpoint to check")
 
-func init() {
-	doctorCmd.Flags().StringVar(&doctorEndpoint, "endpoint", "http://localhost:11434", "Ollama endpoint to check")
-	rootCmd.AddCommand(doctorCmd)
+	return cmd
 }
 
-func runDoctor(cmd *cobra.Command, args []string) error {
-	if !IsQuiet() {
-		fmt.Printf("🏥 FauxFoundry Health Check\n")
-		fmt.Printf("═══════════════════════════\n\n")
+func (a *App) runDoctor(endpoint string) error {
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "🏥 FauxFoundry Health Check\n")
+		fmt.Fprintf(a.Stdout, "═══════════════════════════\n\n")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	// Check system information
-	if !IsQuiet() {
-		fmt.Printf("📋 System Information:\n")
-		fmt.Printf("   • OS: %s\n", runtime.GOOS)
-		fmt.Printf("   • Architecture: %s\n", runtime.GOARCH)
-		fmt.Printf("   • Go version: %s\n", runtime.Version())
-		fmt.Printf("   • CPU cores: %d\n", runtime.NumCPU())
-		fmt.Println()
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "📋 System Information:\n")
+		fmt.Fprintf(a.Stdout, "   • OS: %s\n", runtime.GOOS)
+		fmt.Fprintf(a.Stdout, "   • Architecture: %s\n", runtime.GOARCH)
+		fmt.Fprintf(a.Stdout, "   • Go version: %s\n", runtime.Version())
+		fmt.Fprintf(a.Stdout, "   • CPU cores: %d\n", runtime.NumCPU())
+		fmt.Fprintln(a.Stdout)
+	}
+
+	if !a.Config.Quiet && a.Config.Resolved != nil {
+		fmt.Fprintf(a.Stdout, "⚙️  Effective Model Configuration:\n")
+		fmt.Fprintf(a.Stdout, "   • endpoint: %s (%s)\n", a.Config.Resolved.Endpoint, a.Config.Resolved.Sources["model.endpoint"])
+		fmt.Fprintf(a.Stdout, "   • name: %s (%s)\n", a.Config.Resolved.Name, a.Config.Resolved.Sources["model.name"])
+		fmt.Fprintf(a.Stdout, "   • batch_size: %d (%s)\n", a.Config.Resolved.BatchSize, a.Config.Resolved.Sources["model.batch_size"])
+		fmt.Fprintf(a.Stdout, "   • temperature: %.2f (%s)\n", a.Config.Resolved.Temperature, a.Config.Resolved.Sources["model.temperature"])
+		fmt.Fprintf(a.Stdout, "   • timeout: %s (%s)\n", a.Config.Resolved.Timeout, a.Config.Resolved.Sources["model.timeout"])
+		fmt.Fprintln(a.Stdout)
 	}
 
 	// Check Ollama health
-	client := llm.NewOllamaClient()
-	health, err := client.CheckOllamaHealth(ctx, doctorEndpoint)
+	health, err := a.LLM.CheckOllamaHealth(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to check Ollama health: %w", err)
 	}
 
-	if !IsQuiet() {
-		fmt.Printf("🤖 Ollama Status:\n")
-		fmt.Printf("   • Endpoint: %s\n", health.Endpoint)
-		
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "🤖 Ollama Status:\n")
+		fmt.Fprintf(a.Stdout, "   • Endpoint: %s\n", health.Endpoint)
+
 		if health.IsRunning {
-			fmt.Printf("   • Status: ✅ Running\n")
-			fmt.Printf("   • Version: %s\n", health.Version)
-			fmt.Printf("   • Available models: %d\n", len(health.Models))
-			
+			fmt.Fprintf(a.Stdout, "   • Status: ✅ Running\n")
+			fmt.Fprintf(a.Stdout, "   • Version: %s\n", health.Version)
+			fmt.Fprintf(a.Stdout, "   • Available models: %d\n", len(health.Models))
+
 			if len(health.Models) > 0 {
-				fmt.Printf("   • Models:\n")
+				fmt.Fprintf(a.Stdout, "   • Models:\n")
 				for _, model := range health.Models {
-					fmt.Printf("     - %s\n", model)
+					fmt.Fprintf(a.Stdout, "     - %s\n", model)
 				}
 			}
 		} else {
-			fmt.Printf("   • Status: ❌ Not running\n")
+			fmt.Fprintf(a.Stdout, "   • Status: ❌ Not running\n")
 			if health.ErrorMessage != "" {
-				fmt.Printf("   • Error: %s\n", health.ErrorMessage)
+				fmt.Fprintf(a.Stdout, "   • Error: %s\n", health.ErrorMessage)
 			}
 		}
-		fmt.Println()
+		fmt.Fprintln(a.Stdout)
 	}
 
 	// Provide recommendations
 	if !health.IsRunning {
-		return showOllamaSetupInstructions()
+		return a.showOllamaSetupInstructions()
 	}
 
 	if len(health.Models) == 0 {
-		return showModelInstallInstructions()
+		return a.showModelInstallInstructions()
 	}
 
 	// Check if recommended models are available
 	recommendedModels := llm.GetRecommendedModels()
 	hasRecommended := false
-	
+
 	for _, recommended := range recommendedModels {
 		if recommended.Recommended {
 			for _, available := range health.Models {
@@ -114,43 +126,43 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	}
 
 	if !hasRecommended {
-		if !IsQuiet() {
-			fmt.Printf("💡 Recommendations:\n")
-			fmt.Printf("   Consider installing a recommended model for better performance:\n")
+		if !a.Config.Quiet {
+			fmt.Fprintf(a.Stdout, "💡 Recommendations:\n")
+			fmt.Fprintf(a.Stdout, "   Consider installing a recommended model for better performance:\n")
 			for _, model := range recommendedModels {
 				if model.Recommended {
-					fmt.Printf("   • %s (%s) - %s\n", model.Name, model.Size, model.UseCase)
-					fmt.Printf("     Install: ollama pull %s\n", model.Name)
+					fmt.Fprintf(a.Stdout, "   • %s (%s) - %s\n", model.Name, model.Size, model.UseCase)
+					fmt.Fprintf(a.Stdout, "     Install: ollama pull %s\n", model.Name)
 				}
 			}
-			fmt.Println()
+			fmt.Fprintln(a.Stdout)
 		}
 	}
 
-	if !IsQuiet() {
-		fmt.Printf("✅ System is ready for synthetic data generation!\n")
-		fmt.Printf("\nNext steps:\n")
-		fmt.Printf("  1. Create a specification: fauxfoundry init my-spec.yaml\n")
-		fmt.Printf("  2. Generate data: fauxfoundry generate --spec my-spec.yaml\n")
-		fmt.Printf("  3. Or use the TUI: fauxfoundry tui\n")
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "✅ System is ready for synthetic data generation!\n")
+		fmt.Fprintf(a.Stdout, "\nNext steps:\n")
+		fmt.Fprintf(a.Stdout, "  1. Create a specification: fauxfoundry init my-spec.yaml\n")
+		fmt.Fprintf(a.Stdout, "  2. Generate data: fauxfoundry generate --spec my-spec.yaml\n")
+		fmt.Fprintf(a.Stdout, "  3. Or use the TUI: fauxfoundry tui\n")
 	}
 
 	return nil
 }
 
-func showOllamaSetupInstructions() error {
-	if IsQuiet() {
+func (a *App) showOllamaSetupInstructions() error {
+	if a.Config.Quiet {
 		return fmt.Errorf("Ollama is not running")
 	}
 
-	fmt.Printf("🚨 Ollama Setup Required\n")
-	fmt.Printf("═══════════════════════════\n\n")
-	fmt.Printf("Ollama is not running or not installed. FauxFoundry requires Ollama to generate synthetic data.\n\n")
+	fmt.Fprintf(a.Stdout, "🚨 Ollama Setup Required\n")
+	fmt.Fprintf(a.Stdout, "═══════════════════════════\n\n")
+	fmt.Fprintf(a.Stdout, "Ollama is not running or not installed. FauxFoundry requires Ollama to generate synthetic data.\n\n")
 
 	instructions := llm.GetOllamaInstallInstructions()
-	
-	fmt.Printf("📦 Installation Instructions for %s:\n\n", runtime.GOOS)
-	
+
+	fmt.Fprintf(a.Stdout, "📦 Installation Instructions for %s:\n\n", runtime.GOOS)
+
 	var osInstructions string
 	switch runtime.GOOS {
 	case "darwin":
@@ -163,46 +175,46 @@ func showOllamaSetupInstructions() error {
 		osInstructions = instructions["linux"] // fallback
 	}
 
-	fmt.Printf("%s\n\n", osInstructions)
-	
-	fmt.Printf("🐳 Alternative - Docker:\n\n")
-	fmt.Printf("%s\n\n", instructions["docker"])
-	
-	fmt.Printf("After installation:\n")
-	fmt.Printf("  1. Ensure Ollama is running: ollama serve\n")
-	fmt.Printf("  2. Run health check again: fauxfoundry doctor\n")
-	fmt.Printf("  3. Visit https://ollama.ai for more information\n")
+	fmt.Fprintf(a.Stdout, "%s\n\n", osInstructions)
+
+	fmt.Fprintf(a.Stdout, "🐳 Alternative - Docker:\n\n")
+	fmt.Fprintf(a.Stdout, "%s\n\n", instructions["docker"])
+
+	fmt.Fprintf(a.Stdout, "After installation:\n")
+	fmt.Fprintf(a.Stdout, "  1. Ensure Ollama is running: ollama serve\n")
+	fmt.Fprintf(a.Stdout, "  2. Run health check again: fauxfoundry doctor\n")
+	fmt.Fprintf(a.Stdout, "  3. Visit https://ollama.ai for more information\n")
 
 	return fmt.Errorf("Ollama setup required")
 }
 
-func showModelInstallInstructions() error {
-	if IsQuiet() {
+func (a *App) showModelInstallInstructions() error {
+	if a.Config.Quiet {
 		return fmt.Errorf("no models available")
 	}
 
-	fmt.Printf("📥 Model Installation Required\n")
-	fmt.Printf("═════════════════════════════\n\n")
-	fmt.Printf("Ollama is running but no models are installed. You need at least one model to generate data.\n\n")
+	fmt.Fprintf(a.Stdout, "📥 Model Installation Required\n")
+	fmt.Fprintf(a.Stdout, "═════════════════════════════\n\n")
+	fmt.Fprintf(a.Stdout, "Ollama is running but no models are installed. You need at least one model to generate data.\n\n")
 
 	recommendedModels := llm.GetRecommendedModels()
-	
-	fmt.Printf("🌟 Recommended Models:\n\n")
+
+	fmt.Fprintf(a.Stdout, "🌟 Recommended Models:\n\n")
 	for _, model := range recommendedModels {
 		if model.Recommended {
-			fmt.Printf("• %s (%s)\n", model.Name, model.Size)
-			fmt.Printf("  %s\n", model.Description)
-			fmt.Printf("  Use case: %s\n", model.UseCase)
-			fmt.Printf("  Install: ollama pull %s\n\n", model.Name)
+			fmt.Fprintf(a.Stdout, "• %s (%s)\n", model.Name, model.Size)
+			fmt.Fprintf(a.Stdout, "  %s\n", model.Description)
+			fmt.Fprintf(a.Stdout, "  Use case: %s\n", model.UseCase)
+			fmt.Fprintf(a.Stdout, "  Install: ollama pull %s\n\n", model.Name)
 		}
 	}
 
-	fmt.Printf("💡 Quick start:\n")
-	fmt.Printf("  ollama pull llama3.1:8b  # Recommended for most users\n\n")
-	
-	fmt.Printf("After installing a model:\n")
-	fmt.Printf("  1. Run health check: fauxfoundry doctor\n")
-	fmt.Printf("  2. Create your first spec: fauxfoundry init test.yaml\n")
+	fmt.Fprintf(a.Stdout, "💡 Quick start:\n")
+	fmt.Fprintf(a.Stdout, "  ollama pull llama3.1:8b  # Recommended for most users\n\n")
+
+	fmt.Fprintf(a.Stdout, "After installing a model:\n")
+	fmt.Fprintf(a.Stdout, "  1. Run health check: fauxfoundry doctor\n")
+	fmt.Fprintf(a.Stdout, "  2. Create your first spec: fauxfoundry init test.yaml\n")
 
 	return fmt.Errorf("model installation required")
 }
@@ -8,31 +8,47 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/copyleftdev/faux-foundry/internal/agents"
 	"github.com/copyleftdev/faux-foundry/internal/dedup"
+	"github.com/copyleftdev/faux-foundry/internal/events"
+	"github.com/copyleftdev/faux-foundry/internal/history"
+	"github.com/copyleftdev/faux-foundry/internal/job"
 	"github.com/copyleftdev/faux-foundry/internal/llm"
 	"github.com/copyleftdev/faux-foundry/internal/output"
 	"github.com/copyleftdev/faux-foundry/internal/spec"
+	"github.com/copyleftdev/faux-foundry/internal/tui"
 	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
-var (
-	specFile    string
-	outputFile  string
-	count       int
-	timeout     string
-	seed        int64
-	dryRun      bool
-	interactive bool
-	maxRetries  int
+// generateFlags holds the flag values for the generate subcommand, kept
+// local to newGenerateCmd's closure instead of package-level vars.
+type generateFlags struct {
+	specFile     string
+	outputFile   string
+	sink         string
+	sinkCodec    string
+	events       string
+	count        int
+	timeout      string
+	seed         int64
+	dryRun       bool
+	interactive  bool
+	maxRetries   int
 	minBatchSize int
-)
+	workers      int
+}
 
-// generateCmd represents the generate command
-var generateCmd = &cobra.Command{
-	Use:   "generate",
-	Short: "Generate synthetic data from specification",
-	Long: `Generate synthetic data from a YAML specification file. The command will parse the 
-specification, connect to the configured LLM backend, and generate exactly N unique records 
+// newGenerateCmd builds the generate subcommand, closing over app instead
+// of reading package globals.
+func newGenerateCmd(app *App) *cobra.Command {
+	flags := &generateFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate synthetic data from specification",
+		Long: `Generate synthetic data from a YAML specification file. The command will parse the
+specification, connect to the configured LLM backend, and generate exactly N unique records
 as specified in the configuration.
 
 Examples:
@@ -42,58 +58,69 @@ Examples:
   # Override record count and specify output
   fauxfoundry generate --spec customer.yaml --count 5000 --output data.jsonl.gz
 
+  # Stream records straight into a Kafka topic instead of a file
+  fauxfoundry generate --spec customer.yaml --sink kafka://localhost:9092/customers
+
+  # Stream into NATS JetStream, encoded as msgpack
+  fauxfoundry generate --spec customer.yaml --sink nats://localhost:4222/customers --sink-codec msgpack
+
+  # Publish job lifecycle events to MQTT for an external dashboard
+  fauxfoundry generate --spec customer.yaml --events mqtt://localhost:1883?qos=1
+
   # Dry run to validate specification
   fauxfoundry generate --spec customer.yaml --dry-run
 
   # Interactive mode with TUI
   fauxfoundry generate --interactive`,
-	RunE: runGenerate,
-}
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runGenerate(flags)
+		},
+	}
 
-func init() {
-	generateCmd.Flags().StringVarP(&specFile, "spec", "s", "", "path to YAML specification file (required)")
-	generateCmd.Flags().StringVarP(&outputFile, "output", "o", "", "output file path (stdout if not specified)")
-	generateCmd.Flags().IntVarP(&count, "count", "n", 0, "override record count from specification")
-	generateCmd.Flags().StringVarP(&timeout, "timeout", "t", "2h", "maximum execution time")
-	generateCmd.Flags().Int64Var(&seed, "seed", 0, "random seed for reproducibility")
-	generateCmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate specification without generating data")
-	generateCmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "launch interactive TUI mode")
-	generateCmd.Flags().IntVar(&maxRetries, "max-retries", 3, "maximum number of retry attempts on timeout")
-	generateCmd.Flags().IntVar(&minBatchSize, "min-batch-size", 1, "minimum batch size before giving up")
-
-	// Mark required flags
-	generateCmd.MarkFlagRequired("spec")
+	cmd.Flags().StringVarP(&flags.specFile, "spec", "s", "", "path to YAML specification file (required)")
+	cmd.Flags().StringVarP(&flags.outputFile, "output", "o", "", "output file path (stdout if not specified)")
+	cmd.Flags().StringVar(&flags.sink, "sink", "", "stream records to a message bus instead of a file, e.g. kafka://broker:9092/topic or nats://host:4222/subject")
+	cmd.Flags().StringVar(&flags.sinkCodec, "sink-codec", "json", "encoding for --sink messages: json, msgpack, or protobuf")
+	cmd.Flags().StringVar(&flags.events, "events", "", "publish job lifecycle events to this URI, e.g. stdout://, https://hooks.example.com/job, or mqtt://broker:1883?qos=1")
+	cmd.Flags().IntVarP(&flags.count, "count", "n", 0, "override record count from specification")
+	cmd.Flags().StringVarP(&flags.timeout, "timeout", "t", "2h", "maximum execution time")
+	cmd.Flags().Int64Var(&flags.seed, "seed", 0, "random seed for reproducibility")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "validate specification without generating data")
+	cmd.Flags().BoolVarP(&flags.interactive, "interactive", "i", false, "launch interactive TUI mode")
+	cmd.Flags().IntVar(&flags.maxRetries, "max-retries", 3, "maximum number of retry attempts on timeout")
+	cmd.Flags().IntVar(&flags.minBatchSize, "min-batch-size", 1, "minimum batch size before giving up")
+	cmd.Flags().IntVarP(&flags.workers, "workers", "w", 0, "number of batches to generate concurrently (overrides model.concurrency; default 1, sequential)")
+
+	cmd.MarkFlagRequired("spec")
+
+	return cmd
 }
 
-func runGenerate(cmd *cobra.Command, args []string) error {
-	if interactive {
-		return runInteractiveGenerate()
-	}
-
+func (a *App) runGenerate(flags *generateFlags) error {
 	// Validate inputs
-	if specFile == "" {
+	if flags.specFile == "" {
 		return fmt.Errorf("specification file is required")
 	}
 
-	if !fileExists(specFile) {
-		return fmt.Errorf("specification file not found: %s", specFile)
+	if !fileExists(flags.specFile) {
+		return fmt.Errorf("specification file not found: %s", flags.specFile)
 	}
 
 	// Parse timeout
-	timeoutDuration, err := time.ParseDuration(timeout)
+	timeoutDuration, err := time.ParseDuration(flags.timeout)
 	if err != nil {
-		return fmt.Errorf("invalid timeout format: %s", timeout)
+		return fmt.Errorf("invalid timeout format: %s", flags.timeout)
 	}
 
 	// Load and validate specification
-	specification, err := spec.LoadFromFile(specFile)
+	specification, err := spec.LoadFromFile(flags.specFile)
 	if err != nil {
 		return fmt.Errorf("failed to load specification: %w", err)
 	}
 
 	// Override count if specified
-	if count > 0 {
-		specification.Dataset.Count = count
+	if flags.count > 0 {
+		specification.Dataset.Count = flags.count
 	}
 
 	// Validate specification
@@ -101,23 +128,36 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("specification validation failed: %w", err)
 	}
 
-	if dryRun {
-		fmt.Printf("‚úì Specification is valid\n")
-		fmt.Printf("  Domain: %s\n", specification.Dataset.Domain)
-		fmt.Printf("  Fields: %d\n", len(specification.Dataset.Fields))
-		fmt.Printf("  Target records: %d\n", specification.Dataset.Count)
-		fmt.Printf("  Model: %s\n", specification.Model.Name)
-		fmt.Printf("  Endpoint: %s\n", specification.Model.Endpoint)
+	if flags.dryRun {
+		fmt.Fprintf(a.Stdout, "✓ Specification is valid\n")
+		fmt.Fprintf(a.Stdout, "  Domain: %s\n", specification.Dataset.Domain)
+		fmt.Fprintf(a.Stdout, "  Fields: %d\n", len(specification.Dataset.Fields))
+		fmt.Fprintf(a.Stdout, "  Target records: %d\n", specification.Dataset.Count)
+		fmt.Fprintf(a.Stdout, "  Model: %s\n", specification.Model.Name)
+		fmt.Fprintf(a.Stdout, "  Endpoint: %s\n", specification.Model.Endpoint)
 		return nil
 	}
 
+	// Resolve the --sink codec up front so a bad --sink-codec value fails
+	// fast instead of after the Ollama health check below.
+	var sinkCodec output.Codec
+	if flags.sink != "" {
+		sinkCodec, err = output.CodecFor(flags.sinkCodec)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Determine output path
-	output := outputFile
-	if output == "" {
-		output = "stdout"
-	} else {
+	outputPath := flags.outputFile
+	switch {
+	case flags.sink != "":
+		outputPath = flags.sink
+	case outputPath == "":
+		outputPath = "stdout"
+	default:
 		// Ensure output directory exists
-		if dir := filepath.Dir(output); dir != "." {
+		if dir := filepath.Dir(outputPath); dir != "." {
 			if err := os.MkdirAll(dir, 0755); err != nil {
 				return fmt.Errorf("failed to create output directory: %w", err)
 			}
@@ -130,38 +170,77 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		Spec:       *specification,
 		Status:     types.JobStatusPending,
 		StartTime:  time.Now(),
-		OutputPath: output,
+		OutputPath: outputPath,
 		Progress: types.Progress{
 			Target: specification.Dataset.Count,
 		},
 	}
 
-	if !IsQuiet() {
-		fmt.Printf("Starting data generation...\n")
-		fmt.Printf("  Specification: %s\n", specFile)
-		fmt.Printf("  Output: %s\n", output)
-		fmt.Printf("  Target records: %d\n", job.Progress.Target)
-		fmt.Printf("  Timeout: %s\n", timeoutDuration)
-		if seed != 0 {
-			fmt.Printf("  Seed: %d\n", seed)
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "Starting data generation...\n")
+		fmt.Fprintf(a.Stdout, "  Specification: %s\n", flags.specFile)
+		fmt.Fprintf(a.Stdout, "  Output: %s\n", outputPath)
+		fmt.Fprintf(a.Stdout, "  Target records: %d\n", job.Progress.Target)
+		fmt.Fprintf(a.Stdout, "  Timeout: %s\n", timeoutDuration)
+		if flags.seed != 0 {
+			fmt.Fprintf(a.Stdout, "  Seed: %d\n", flags.seed)
 		}
-		fmt.Println()
+		fmt.Fprintln(a.Stdout)
 	}
 
-	// Start actual generation
-	return runGeneration(job, timeoutDuration)
-}
+	// workers resolves --workers over model.concurrency, defaulting to
+	// sequential (1) when neither is set.
+	workers := flags.workers
+	if workers <= 0 {
+		workers = specification.Model.Concurrency
+	}
 
-func runInteractiveGenerate() error {
-	// TODO: Launch TUI mode
-	return fmt.Errorf("interactive mode not yet implemented - use 'fauxfoundry tui' instead")
+	// Start actual generation
+	a.recordActivity("generation_started", flags.specFile)
+	if flags.interactive {
+		return a.runInteractiveGeneration(job, timeoutDuration, flags.maxRetries, flags.minBatchSize, flags.sink, sinkCodec, flags.events, workers)
+	}
+	return a.runGeneration(job, timeoutDuration, flags.maxRetries, flags.minBatchSize, flags.sink, sinkCodec, flags.events, workers)
 }
 
-func runGeneration(job *types.GenerationJob, timeout time.Duration) error {
+// runInteractiveGeneration builds the same Controller runGeneration drives,
+// but hands it to the Bubble Tea monitor instead of printing progress lines
+// to a.Stdout, so --interactive gets a live bar/preview instead of a log.
+func (a *App) runInteractiveGeneration(genJob *types.GenerationJob, timeout time.Duration, maxRetries, minBatchSize int, sink string, sinkCodec output.Codec, eventsURI string, workers int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	// Initialize components with custom retry config
+	controller, err := a.prepareController(ctx, genJob, maxRetries, minBatchSize, sink, sinkCodec, eventsURI, workers)
+	if err != nil {
+		return err
+	}
+	defer controller.Close()
+
+	if err := tui.RunGenerationMonitor(ctx, cancel, controller); err != nil {
+		a.recordActivity("generation_failed", err.Error())
+		return err
+	}
+
+	a.recordHistory(genJob, controller.Dedup.GetStats())
+	a.recordActivity("generation_finished", fmt.Sprintf("%d records", genJob.Progress.Generated))
+	return nil
+}
+
+// backendLabel returns provider for display, substituting "Ollama" for the
+// empty/default value so messages read naturally either way.
+func backendLabel(provider string) string {
+	if provider == "" || provider == "ollama" {
+		return "Ollama"
+	}
+	return provider
+}
+
+// prepareController builds the job.Controller shared by the plain CLI
+// generation path (runGeneration) and the interactive TUI monitor
+// (runInteractiveGeneration): the output writer, deduplicator,
+// field-tool executor, events bus, and backend health check all happen
+// once here instead of being duplicated by both callers.
+func (a *App) prepareController(ctx context.Context, j *types.GenerationJob, maxRetries, minBatchSize int, sink string, sinkCodec output.Codec, eventsURI string, workers int) (*job.Controller, error) {
 	retryConfig := &llm.RetryConfig{
 		MaxRetries:            maxRetries,
 		BaseTimeout:           30 * time.Second,
@@ -170,173 +249,215 @@ func runGeneration(job *types.GenerationJob, timeout time.Duration) error {
 		ReduceFactorOnTimeout: 0.5,
 		MinBatchSize:          minBatchSize,
 	}
-	
-	llmClient := llm.NewOllamaClient()
-	deduplicator := dedup.NewBatchDeduplicator(job.Spec.Model.BatchSize)
-	
-	// Create output writer
-	writer, err := output.NewStreamingWriter(job.OutputPath, 100) // Buffer 100 records
+
+	deduplicator := dedup.NewBatchDeduplicator(j.Spec.Model.BatchSize)
+
+	// agentExecutor runs any field-level tool calls (regex_sample, faker,
+	// lookup_reference, sql_query) a spec declared, overwriting the
+	// corresponding field on every record after the model generates it.
+	// Building one is cheap even when no field declares a tool, so it's
+	// unconditional rather than gated on job.Spec having any.
+	agentExecutor := agents.NewExecutor(a.Logger, a.Config.ReferenceDir)
+
+	// Create output writer: a --sink URI streams to a message bus instead
+	// of a file.
+	var writer *output.StreamingWriter
+	var err error
+	if sink != "" {
+		baseAttrs := map[string]string{
+			"spec":  j.Spec.Dataset.Domain,
+			"model": j.Spec.Model.Name,
+		}
+		writer, err = output.OpenSink(ctx, sink, 100, sinkCodec, baseAttrs) // Buffer 100 records
+	} else {
+		writer, err = output.NewStreamingWriter(j.OutputPath, 100) // Buffer 100 records
+	}
 	if err != nil {
-		return fmt.Errorf("failed to create output writer: %w", err)
+		return nil, fmt.Errorf("failed to create output writer: %w", err)
 	}
-	defer writer.Close()
 
-	// Check Ollama health before generation
-	health, err := llmClient.CheckOllamaHealth(ctx, job.Spec.Model.Endpoint)
+	// bus publishes the job's lifecycle (job.started, batch.completed,
+	// record.written, dedup.duplicate, job.failed, job.completed) to
+	// --events, if set, so an external dashboard or orchestrator can watch
+	// a long-running job without polling the output file. A bus with a nil
+	// Sink (the common case, --events unset) makes every Publish call a
+	// no-op.
+	var bus *events.Bus
+	if eventsURI != "" {
+		eventSink, sinkErr := events.OpenSink(eventsURI)
+		if sinkErr != nil {
+			writer.Close()
+			return nil, fmt.Errorf("failed to open events sink: %w", sinkErr)
+		}
+		bus = events.NewBus(eventSink, j.ID, func(event events.Event, err error) {
+			a.Logger.Warn("failed to publish event", "event", event.Type, "error", err)
+		})
+	} else {
+		bus = events.NewBus(nil, j.ID, nil)
+	}
+
+	// Check backend health before generation. For the default/"ollama"
+	// provider this hits Ollama's own health endpoint; for any other
+	// provider (openai, anthropic, google/gemini) it checks that provider
+	// instead, via the same CheckModelHealth call.
+	backend := j.Spec.Model.Provider
+	isOllamaBackend := backend == "" || backend == "ollama"
+
+	health, err := a.LLM.CheckModelHealth(ctx, &j.Spec)
 	if err != nil {
-		return fmt.Errorf("failed to check Ollama health: %w", err)
+		writer.Close()
+		bus.Close()
+		return nil, fmt.Errorf("failed to check %s health: %w", backendLabel(backend), err)
 	}
 
 	if !health.IsRunning {
-		if !IsQuiet() {
-			fmt.Printf("‚ùå Ollama is not running at %s\n", job.Spec.Model.Endpoint)
-			fmt.Printf("üí° Run 'fauxfoundry doctor' for setup instructions\n")
+		if !a.Config.Quiet {
+			if isOllamaBackend {
+				fmt.Fprintf(a.Stdout, "❌ Ollama is not running at %s\n", j.Spec.Model.Endpoint)
+				fmt.Fprintf(a.Stdout, "💡 Run 'fauxfoundry doctor' for setup instructions\n")
+			} else {
+				fmt.Fprintf(a.Stdout, "❌ %s is not reachable: %s\n", backendLabel(backend), health.ErrorMessage)
+			}
 		}
-		return fmt.Errorf("Ollama is not running: %s", health.ErrorMessage)
+		writer.Close()
+		bus.Close()
+		return nil, fmt.Errorf("%s is not available: %s", backendLabel(backend), health.ErrorMessage)
 	}
 
-	// Check if the specified model is available
-	modelAvailable := false
-	for _, model := range health.Models {
-		if model == job.Spec.Model.Name {
-			modelAvailable = true
-			break
+	// Check if the specified model is available. Hosted providers that
+	// can't enumerate models (ListModels returning none) aren't treated as
+	// a failure here the way an unreachable Ollama model would be - we only
+	// reject when the provider gave us a list and the model isn't on it.
+	if len(health.Models) > 0 {
+		modelAvailable := false
+		for _, model := range health.Models {
+			if model == j.Spec.Model.Name {
+				modelAvailable = true
+				break
+			}
 		}
-	}
 
-	if !modelAvailable {
-		if !IsQuiet() {
-			fmt.Printf("‚ùå Model '%s' is not available\n", job.Spec.Model.Name)
-			fmt.Printf("üìã Available models: %v\n", health.Models)
-			fmt.Printf("üí° Install the model: ollama pull %s\n", job.Spec.Model.Name)
+		if !modelAvailable {
+			if !a.Config.Quiet {
+				fmt.Fprintf(a.Stdout, "❌ Model '%s' is not available\n", j.Spec.Model.Name)
+				fmt.Fprintf(a.Stdout, "📋 Available models: %v\n", health.Models)
+				if isOllamaBackend {
+					fmt.Fprintf(a.Stdout, "💡 Install the model: ollama pull %s\n", j.Spec.Model.Name)
+				}
+			}
+			writer.Close()
+			bus.Close()
+			return nil, fmt.Errorf("model '%s' is not available", j.Spec.Model.Name)
 		}
-		return fmt.Errorf("model '%s' is not available", job.Spec.Model.Name)
 	}
 
-	if !IsQuiet() {
-		fmt.Printf("üöÄ Generation started (Job ID: %s)\n", job.ID)
-		fmt.Printf("ü§ñ Connected to %s at %s\n", job.Spec.Model.Name, job.Spec.Model.Endpoint)
-		fmt.Println()
-	}
-
-	// Generation loop
-	job.Status = types.JobStatusRunning
-	startTime := time.Now()
-	generated := 0
-	batchCount := 0
-
-	for generated < job.Progress.Target {
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("generation timed out after %s", timeout)
-		default:
-		}
+	return job.NewController(a.LLM, writer, deduplicator, agentExecutor, bus, a.Logger, j, retryConfig, workers), nil
+}
 
-		// Calculate batch size for this iteration
-		remaining := job.Progress.Target - generated
-		batchSize := job.Spec.Model.BatchSize
-		if remaining < batchSize {
-			batchSize = remaining
-		}
+func (a *App) runGeneration(genJob *types.GenerationJob, timeout time.Duration, maxRetries, minBatchSize int, sink string, sinkCodec output.Codec, eventsURI string, workers int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-		batchCount++
-		if !IsQuiet() {
-			fmt.Printf("üì¶ Generating batch %d (%d records)...\n", batchCount, batchSize)
-		}
+	controller, err := a.prepareController(ctx, genJob, maxRetries, minBatchSize, sink, sinkCodec, eventsURI, workers)
+	if err != nil {
+		return err
+	}
+	defer controller.Close()
 
-		// Generate batch with custom retry config
-		records, err := llmClient.GenerateWithConfig(ctx, &job.Spec, batchSize, retryConfig)
-		if err != nil {
-			return fmt.Errorf("failed to generate batch %d: %w", batchCount, err)
-		}
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "🚀 Generation started (Job ID: %s)\n", genJob.ID)
+		fmt.Fprintf(a.Stdout, "🤖 Connected to %s at %s\n", genJob.Spec.Model.Name, genJob.Spec.Model.Endpoint)
+		fmt.Fprintln(a.Stdout)
+	}
 
-		// Deduplicate records
-		uniqueRecords := deduplicator.ProcessBatch(records)
-		
-		// Write unique records
-		for _, record := range uniqueRecords {
-			if err := writer.Write(record); err != nil {
-				return fmt.Errorf("failed to write record: %w", err)
+	// Drain Updates (printing batch/progress lines) and Records (no CLI use
+	// for the preview, but Controller.Run's send on Records blocks, so
+	// without a reader Run would stall once the channel's buffer fills).
+	printDone := make(chan struct{})
+	go func() {
+		defer close(printDone)
+		for snap := range controller.Updates {
+			if a.Config.Quiet {
+				continue
 			}
-			generated++
-			
-			// Update progress
-			job.Progress.Generated = generated
-			elapsed := time.Since(startTime)
-			job.Progress.ElapsedTime = elapsed.String()
-			
-			if generated > 0 {
-				rate := float64(generated) / elapsed.Seconds()
-				job.Progress.Rate = rate
-				
-				if rate > 0 {
-					remaining := job.Progress.Target - generated
-					eta := time.Duration(float64(remaining)/rate) * time.Second
-					job.Progress.EstimatedETA = eta.String()
-				}
+			switch snap.Phase {
+			case job.PhaseBatchStarted:
+				fmt.Fprintf(a.Stdout, "📦 Generating batch %d (%d records)...\n", snap.BatchNumber, snap.Progress.BatchSize)
+			case job.PhaseBatchCompleted:
+				progress := float64(snap.Progress.Generated) / float64(snap.Progress.Target) * 100
+				fmt.Fprintf(a.Stdout, "📈 Progress: %.1f%% (%d/%d records) | %s\n",
+					progress, snap.Progress.Generated, snap.Progress.Target, snap.Dedup.String())
 			}
 		}
-
-		// Show progress
-		if !IsQuiet() {
-			stats := deduplicator.GetStats()
-			progress := float64(generated) / float64(job.Progress.Target) * 100
-			fmt.Printf("üìà Progress: %.1f%% (%d/%d records) | %s\n", 
-				progress, generated, job.Progress.Target, stats.String())
+	}()
+	go func() {
+		for range controller.Records {
 		}
+	}()
 
-		// Break if we've reached the target
-		if generated >= job.Progress.Target {
-			break
-		}
-	}
+	runErr := controller.Run(ctx)
+	<-printDone
 
-	// Finalize
-	job.Status = types.JobStatusCompleted
-	endTime := time.Now()
-	job.EndTime = &endTime
-	
-	// Final flush
-	if err := writer.Flush(); err != nil {
-		return fmt.Errorf("failed to flush output: %w", err)
+	if runErr != nil {
+		a.recordActivity("generation_failed", runErr.Error())
+		return runErr
 	}
 
-	if !IsQuiet() {
-		totalTime := endTime.Sub(startTime)
-		finalStats := deduplicator.GetStats()
-		
-		fmt.Printf("\n‚úÖ Generation completed successfully!\n")
-		fmt.Printf("üìä Final Statistics:\n")
-		fmt.Printf("   ‚Ä¢ Records generated: %d\n", generated)
-		fmt.Printf("   ‚Ä¢ %s\n", finalStats.String())
-		fmt.Printf("   ‚Ä¢ Total time: %s\n", totalTime.String())
-		fmt.Printf("   ‚Ä¢ Average rate: %.2f records/second\n", float64(generated)/totalTime.Seconds())
-		fmt.Printf("üìÅ Output written to: %s\n", writer.GetPath())
+	if !a.Config.Quiet {
+		totalTime := genJob.EndTime.Sub(genJob.StartTime)
+		finalStats := controller.Dedup.GetStats()
+		generated := genJob.Progress.Generated
+
+		fmt.Fprintf(a.Stdout, "\n✅ Generation completed successfully!\n")
+		fmt.Fprintf(a.Stdout, "📊 Final Statistics:\n")
+		fmt.Fprintf(a.Stdout, "   • Records generated: %d\n", generated)
+		fmt.Fprintf(a.Stdout, "   • %s\n", finalStats.String())
+		fmt.Fprintf(a.Stdout, "   • Total time: %s\n", totalTime.String())
+		fmt.Fprintf(a.Stdout, "   • Average rate: %.2f records/second\n", float64(generated)/totalTime.Seconds())
+		fmt.Fprintf(a.Stdout, "📁 Output written to: %s\n", controller.Writer.GetPath())
 	}
 
+	a.recordHistory(genJob, controller.Dedup.GetStats())
+	a.recordActivity("generation_finished", fmt.Sprintf("%d records", genJob.Progress.Generated))
+
 	return nil
 }
 
-func simulateGeneration(job *types.GenerationJob, timeout time.Duration) error {
-	if !IsQuiet() {
-		fmt.Printf("üöÄ Generation started (Job ID: %s)\n", job.ID)
-		fmt.Printf("‚è±Ô∏è  This is a simulation - actual implementation coming soon!\n")
-		fmt.Printf("üìä Would generate %d records to: %s\n", job.Progress.Target, job.OutputPath)
-		fmt.Printf("ü§ñ Would use model: %s at %s\n", job.Spec.Model.Name, job.Spec.Model.Endpoint)
-		fmt.Println()
-		
-		// Simulate some progress
-		for i := 0; i < 5; i++ {
-			time.Sleep(200 * time.Millisecond)
-			progress := (i + 1) * 20
-			fmt.Printf("üìà Progress: %d%% (%d/%d records)\n", progress, progress*job.Progress.Target/100, job.Progress.Target)
-		}
-		
-		fmt.Printf("\n‚úÖ Generation completed successfully!\n")
-		fmt.Printf("üìÅ Output written to: %s\n", job.OutputPath)
+// recordHistory saves job (and stats) to the history store so `fauxfoundry
+// history list`/`history branch` can see it later. Like the OTLP flush
+// above, a failure here only gets a warning: losing the history entry
+// shouldn't turn an otherwise-successful generation run into a failed one.
+func (a *App) recordHistory(job *types.GenerationJob, stats dedup.DeduplicationStats) {
+	store, err := a.openHistoryStore()
+	if err != nil {
+		a.Logger.Warn("failed to open history store", "error", err)
+		return
+	}
+	defer store.Close()
+
+	specHash, err := history.HashSpec(&job.Spec)
+	if err != nil {
+		a.Logger.Warn("failed to hash spec for history", "error", err)
+		return
+	}
+	job.SpecHash = specHash
+
+	run := &history.Run{
+		JobID:      job.ID,
+		ParentID:   job.ParentID,
+		SpecHash:   specHash,
+		Spec:       job.Spec,
+		Model:      job.Spec.Model.Name,
+		Provider:   job.Spec.Model.Provider,
+		OutputPath: job.OutputPath,
+		Status:     job.Status,
+		DedupStats: stats,
+		CreatedAt:  job.StartTime,
+	}
+
+	if err := store.Save(run); err != nil {
+		a.Logger.Warn("failed to record run history", "error", err)
 	}
-	
-	return nil
 }
 
 func generateJobID() string {
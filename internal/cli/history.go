@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/copyleftdev/faux-foundry/internal/history"
+	"github.com/copyleftdev/faux-foundry/internal/output"
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// defaultHistoryDBPath returns $HOME/.fauxfoundry/history.db, falling back
+// to a relative path in the current directory if the home directory can't
+// be resolved (the same fallback spirit as cliconfig.Load's config search).
+func defaultHistoryDBPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".fauxfoundry-history.db"
+	}
+	return filepath.Join(home, ".fauxfoundry", "history.db")
+}
+
+// openHistoryStore opens a.Config.HistoryDB, creating its parent directory
+// first since history.Open (like dedup.Open) doesn't create one itself.
+func (a *App) openHistoryStore() (*history.Store, error) {
+	if dir := filepath.Dir(a.Config.HistoryDB); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+	return history.Open(a.Config.HistoryDB)
+}
+
+// newHistoryCmd builds the history subcommand and its list/branch children.
+func newHistoryCmd(app *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Inspect and branch from prior generation runs",
+		Long: `FauxFoundry records a snapshot of every generation run (spec, model, output
+path, and dedup stats; the --seed value is not recorded) in a local history store. "history list" shows
+that log; "history branch" re-runs from a copy of an earlier run's spec,
+optionally letting you edit it first, while keeping the parent linkage so the
+run tree stays traceable.`,
+	}
+
+	cmd.AddCommand(newHistoryListCmd(app))
+	cmd.AddCommand(newHistoryBranchCmd(app))
+
+	return cmd
+}
+
+func newHistoryListCmd(app *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List recorded generation runs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runHistoryList()
+		},
+	}
+}
+
+func (a *App) runHistoryList() error {
+	store, err := a.openHistoryStore()
+	if err != nil {
+		return fmt.Errorf("failed to open history store: %w", err)
+	}
+	defer store.Close()
+
+	runs, err := store.List()
+	if err != nil {
+		return fmt.Errorf("failed to list runs: %w", err)
+	}
+
+	if len(runs) == 0 {
+		fmt.Fprintln(a.Stdout, "No generation runs recorded yet.")
+		return nil
+	}
+
+	for _, run := range runs {
+		parent := run.ParentID
+		if parent == "" {
+			parent = "-"
+		}
+		fmt.Fprintf(a.Stdout, "%s  %-10s  parent=%-20s  domain=%-20s  %s\n",
+			run.CreatedAt.Format(time.RFC3339), run.Status, parent, run.Spec.Dataset.Domain, run.JobID)
+	}
+	return nil
+}
+
+// historyBranchFlags holds newHistoryBranchCmd's flag values.
+type historyBranchFlags struct {
+	outputFile   string
+	timeout      string
+	editSpec     bool
+	maxRetries   int
+	minBatchSize int
+	workers      int
+}
+
+func newHistoryBranchCmd(app *App) *cobra.Command {
+	flags := &historyBranchFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "branch <job_id>",
+		Short: "Re-run from a prior run's spec, keeping the parent linkage",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runHistoryBranch(args[0], flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.outputFile, "output", "o", "", "output file path (stdout if not specified)")
+	cmd.Flags().StringVarP(&flags.timeout, "timeout", "t", "2h", "maximum execution time")
+	cmd.Flags().BoolVar(&flags.editSpec, "edit-spec", false, "open the parent run's spec in $EDITOR before branching")
+	cmd.Flags().IntVar(&flags.maxRetries, "max-retries", 3, "maximum number of retry attempts on timeout")
+	cmd.Flags().IntVar(&flags.minBatchSize, "min-batch-size", 1, "minimum batch size before giving up")
+	cmd.Flags().IntVarP(&flags.workers, "workers", "w", 0, "number of batches to generate concurrently (overrides model.concurrency; default 1, sequential)")
+
+	return cmd
+}
+
+func (a *App) runHistoryBranch(parentJobID string, flags *historyBranchFlags) error {
+	store, err := a.openHistoryStore()
+	if err != nil {
+		return fmt.Errorf("failed to open history store: %w", err)
+	}
+	defer store.Close()
+
+	parent, err := store.Get(parentJobID)
+	if err != nil {
+		return fmt.Errorf("failed to load parent run: %w", err)
+	}
+
+	specFile, err := os.CreateTemp("", "fauxfoundry-branch-*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to create temp spec file: %w", err)
+	}
+	defer os.Remove(specFile.Name())
+	specFile.Close()
+
+	if err := spec.SaveToFile(&parent.Spec, specFile.Name()); err != nil {
+		return fmt.Errorf("failed to write parent spec: %w", err)
+	}
+
+	if flags.editSpec {
+		if err := a.editFile(specFile.Name()); err != nil {
+			return err
+		}
+	}
+
+	specification, err := spec.LoadFromFile(specFile.Name())
+	if err != nil {
+		return fmt.Errorf("failed to load branched specification: %w", err)
+	}
+	if err := spec.Validate(specification); err != nil {
+		return fmt.Errorf("branched specification validation failed: %w", err)
+	}
+
+	timeoutDuration, err := time.ParseDuration(flags.timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout format: %s", flags.timeout)
+	}
+
+	outputPath := flags.outputFile
+	if outputPath == "" {
+		outputPath = "stdout"
+	} else if dir := filepath.Dir(outputPath); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	job := &types.GenerationJob{
+		ID:         generateJobID(),
+		Spec:       *specification,
+		Status:     types.JobStatusPending,
+		StartTime:  time.Now(),
+		OutputPath: outputPath,
+		ParentID:   parent.JobID,
+		Progress: types.Progress{
+			Target: specification.Dataset.Count,
+		},
+	}
+
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "Branching from %s\n", parent.JobID)
+		fmt.Fprintf(a.Stdout, "  Output: %s\n", outputPath)
+		fmt.Fprintf(a.Stdout, "  Target records: %d\n", job.Progress.Target)
+		fmt.Fprintln(a.Stdout)
+	}
+
+	workers := flags.workers
+	if workers <= 0 {
+		workers = specification.Model.Concurrency
+	}
+
+	var sinkCodec output.Codec
+	return a.runGeneration(job, timeoutDuration, flags.maxRetries, flags.minBatchSize, "", sinkCodec, "", workers)
+}
+
+// editFile opens path in $EDITOR (falling back to vi), blocking until the
+// editor exits, with the CLI's own stdio wired through so it behaves like
+// an interactive editor session rather than a detached subprocess.
+func (a *App) editFile(path string) error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	cmd := exec.Command(editor, path)
+	cmd.Stdin = a.Stdin
+	cmd.Stdout = a.Stdout
+	cmd.Stderr = a.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run editor %q: %w", editor, err)
+	}
+	return nil
+}
@@ -6,19 +6,20 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
+
 	"github.com/copyleftdev/faux-foundry/internal/spec"
 )
 
-var (
-	template string
-	force    bool
-)
+// newInitCmd builds the init subcommand, closing over app instead of
+// reading package globals.
+func newInitCmd(app *App) *cobra.Command {
+	var template string
+	var force bool
 
-// initCmd represents the init command
-var initCmd = &cobra.Command{
-	Use:   "init [spec-file]",
-	Short: "Initialize new specifications interactively",
-	Long: `Initialize a new YAML specification file interactively. This command will guide you 
+	cmd := &cobra.Command{
+		Use:   "init [spec-file]",
+		Short: "Initialize new specifications interactively",
+		Long: `Initialize a new YAML specification file interactively. This command will guide you
 through creating a specification by asking questions about your data requirements.
 
 If no filename is provided, it will create a specification based on the domain name.
@@ -32,78 +33,81 @@ Examples:
 
   # Force overwrite existing file
   fauxfoundry init --force customer.yaml`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runInit,
-}
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runInit(args, template, force)
+		},
+	}
 
-func init() {
-	initCmd.Flags().StringVarP(&template, "template", "t", "", "template to use (ecommerce, user, product, etc.)")
-	initCmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite existing file")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "template to use (ecommerce, user, product, etc.)")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite existing file")
+
+	return cmd
 }
 
-func runInit(cmd *cobra.Command, args []string) error {
+func (a *App) runInit(args []string, template string, force bool) error {
 	var specPath string
-	
+
 	if len(args) > 0 {
 		specPath = args[0]
 	} else {
 		specPath = "specification.yaml"
 	}
-	
+
 	// Check if file exists and force flag
 	if fileExists(specPath) && !force {
 		return fmt.Errorf("file %s already exists (use --force to overwrite)", specPath)
 	}
-	
+
 	// Ensure directory exists
 	if dir := filepath.Dir(specPath); dir != "." {
 		if err := os.MkdirAll(dir, 0755); err != nil {
 			return fmt.Errorf("failed to create directory: %w", err)
 		}
 	}
-	
-	if !IsQuiet() {
-		fmt.Printf("üöÄ Creating new FauxFoundry specification: %s\n\n", specPath)
+
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "🚀 Creating new FauxFoundry specification: %s\n\n", specPath)
 	}
-	
+
 	var specification *spec.Specification
 	var err error
-	
+
 	if template != "" {
 		// Create from template
 		specification, err = createFromTemplate(template)
 		if err != nil {
 			return fmt.Errorf("failed to create from template: %w", err)
 		}
-		
-		if !IsQuiet() {
-			fmt.Printf("üìã Using template: %s\n", template)
+
+		if !a.Config.Quiet {
+			fmt.Fprintf(a.Stdout, "📋 Using template: %s\n", template)
 		}
 	} else {
 		// Create interactively
-		specification, err = createInteractively()
+		specification, err = a.createInteractively()
 		if err != nil {
 			return fmt.Errorf("failed to create specification: %w", err)
 		}
 	}
-	
+
 	// Save specification
 	if err := spec.SaveToFile(specification, specPath); err != nil {
 		return fmt.Errorf("failed to save specification: %w", err)
 	}
-	
-	if !IsQuiet() {
-		fmt.Printf("\n‚úÖ Specification created successfully!\n")
-		fmt.Printf("üìÅ File: %s\n", specPath)
-		fmt.Printf("üéØ Domain: %s\n", specification.Dataset.Domain)
-		fmt.Printf("üìä Fields: %d\n", len(specification.Dataset.Fields))
-		fmt.Printf("üî¢ Target records: %d\n", specification.Dataset.Count)
-		fmt.Printf("\nNext steps:\n")
-		fmt.Printf("  1. Review and customize the specification\n")
-		fmt.Printf("  2. Validate: fauxfoundry validate %s\n", specPath)
-		fmt.Printf("  3. Generate: fauxfoundry generate --spec %s\n", specPath)
+
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "\n✅ Specification created successfully!\n")
+		fmt.Fprintf(a.Stdout, "📁 File: %s\n", specPath)
+		fmt.Fprintf(a.Stdout, "🎯 Domain: %s\n", specification.Dataset.Domain)
+		fmt.Fprintf(a.Stdout, "📊 Fields: %d\n", len(specification.Dataset.Fields))
+		fmt.Fprintf(a.Stdout, "🔢 Target records: %d\n", specification.Dataset.Count)
+		fmt.Fprintf(a.Stdout, "\nNext steps:\n")
+		fmt.Fprintf(a.Stdout, "  1. Review and customize the specification\n")
+		fmt.Fprintf(a.Stdout, "  2. Validate: fauxfoundry validate %s\n", specPath)
+		fmt.Fprintf(a.Stdout, "  3. Generate: fauxfoundry generate --spec %s\n", specPath)
 	}
-	
+
 	return nil
 }
 
@@ -168,7 +172,7 @@ func createFromTemplate(templateName string) (*spec.Specification, error) {
 			},
 		},
 	}
-	
+
 	template, exists := templates[templateName]
 	if !exists {
 		available := make([]string, 0, len(templates))
@@ -177,18 +181,18 @@ func createFromTemplate(templateName string) (*spec.Specification, error) {
 		}
 		return nil, fmt.Errorf("unknown template '%s'. Available templates: %v", templateName, available)
 	}
-	
+
 	return template, nil
 }
 
-func createInteractively() (*spec.Specification, error) {
+func (a *App) createInteractively() (*spec.Specification, error) {
 	// TODO: Implement interactive specification creation
 	// For now, return a basic template
-	if !IsQuiet() {
-		fmt.Printf("üîß Interactive mode not yet fully implemented.\n")
-		fmt.Printf("üìã Creating basic template - you can customize it manually.\n\n")
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "🔧 Interactive mode not yet fully implemented.\n")
+		fmt.Fprintf(a.Stdout, "📋 Creating basic template - you can customize it manually.\n\n")
 	}
-	
+
 	return &spec.Specification{
 		Model: spec.ModelConfig{
 			Endpoint:    "http://localhost:11434",
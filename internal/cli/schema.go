@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+)
+
+// newSchemaCmd builds the schema subcommand, closing over app instead of
+// reading package globals.
+func newSchemaCmd(app *App) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema for specification files",
+		Long: `Print the canonical JSON Schema (draft 2020-12) FauxFoundry validates specifications
+against. It's the same schema ParseYAML checks every spec against before semantic
+validation runs, so an editor (e.g. the VS Code YAML extension) pointed at it gets the
+same autocompletion and inline errors the CLI does.
+
+Examples:
+  # Print the schema to stdout
+  fauxfoundry schema
+
+  # Write it to a file for an editor to reference
+  fauxfoundry schema --output specification.schema.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runSchema(output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the schema to (default: stdout)")
+
+	return cmd
+}
+
+func (a *App) runSchema(output string) error {
+	data, err := json.MarshalIndent(spec.JSONSchema(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON schema: %w", err)
+	}
+	data = append(data, '\n')
+
+	if output == "" {
+		_, err := a.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "📄 Schema written to: %s\n", output)
+	}
+	return nil
+}
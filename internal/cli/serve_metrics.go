@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/spf13/cobra"
+)
+
+// newServeMetricsCmd builds the serve-metrics subcommand, closing over app
+// instead of reading package globals.
+func newServeMetricsCmd(app *App) *cobra.Command {
+	var listen string
+	var pushgatewayURL string
+	var pushJob string
+	var pushInterval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "serve-metrics",
+		Short: "Expose generation metrics on a Prometheus /metrics endpoint",
+		Long: `Start an HTTP server exposing the Prometheus metrics generate records (batch
+attempts, batch duration, records generated, timeout-strategy transitions, and fallback
+usage) on --listen. Pass --pushgateway for short-lived generate runs that finish before a
+Prometheus scrape would ever catch them: serve-metrics pushes the same metrics to it every
+--push-interval until the command is stopped.
+
+Examples:
+  # Serve metrics for a scrape target to pull from
+  fauxfoundry serve-metrics --listen :9090
+
+  # Also push to a Pushgateway for a short-lived CI run
+  fauxfoundry serve-metrics --pushgateway http://pushgateway:9091 --pushgateway-job nightly-generate`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runServeMetrics(cmd.Context(), listen, pushgatewayURL, pushJob, pushInterval)
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", ":9090", "address to serve /metrics on")
+	cmd.Flags().StringVar(&pushgatewayURL, "pushgateway", "", "Prometheus Pushgateway URL to additionally push metrics to")
+	cmd.Flags().StringVar(&pushJob, "pushgateway-job", "fauxfoundry", "job label to push metrics to the Pushgateway under")
+	cmd.Flags().DurationVar(&pushInterval, "push-interval", 15*time.Second, "how often to push to --pushgateway")
+
+	return cmd
+}
+
+// runServeMetrics serves a.Metrics on listen until ctx is cancelled, and if
+// pushgatewayURL is set, additionally pushes it there every pushInterval.
+func (a *App) runServeMetrics(ctx context.Context, listen, pushgatewayURL, pushJob string, pushInterval time.Duration) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(a.Metrics.Gatherer(), promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: listen, Handler: mux}
+
+	if pushgatewayURL != "" {
+		pusher := push.New(pushgatewayURL, pushJob).Gatherer(a.Metrics.Gatherer())
+		ticker := time.NewTicker(pushInterval)
+		defer ticker.Stop()
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := pusher.Push(); err != nil {
+						a.Logger.Warn("failed to push metrics", "pushgateway", pushgatewayURL, "error", err)
+					}
+				}
+			}
+		}()
+	}
+
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "📈 Serving metrics on %s/metrics\n", listen)
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- server.ListenAndServe() }()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		if err != nil && err != http.ErrServerClosed {
+			return fmt.Errorf("metrics server failed: %w", err)
+		}
+		return nil
+	}
+}
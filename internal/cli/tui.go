@@ -2,17 +2,32 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+
 	"github.com/copyleftdev/faux-foundry/internal/tui"
 )
 
-// tuiCmd represents the tui command
-var tuiCmd = &cobra.Command{
-	Use:   "tui",
-	Short: "Launch interactive terminal interface",
-	Long: `Launch the interactive Terminal User Interface (TUI) for FauxFoundry. The TUI provides
+// newTUICmd builds the tui subcommand, closing over app instead of reading
+// package globals.
+func newTUICmd(app *App) *cobra.Command {
+	var specFile string
+	var plain bool
+
+	cmd := &cobra.Command{
+		// noTermCache is read from app.Config.NoTermCache rather than its
+		// own flag, so the root --no-term-cache flag covers `tui` the same
+		// way it covers every other subcommand.
+		Use:   "tui [path]",
+		Short: "Launch interactive terminal interface",
+		Long: `Launch the interactive Terminal User Interface (TUI) for FauxFoundry. The TUI provides
 a rich, keyboard-driven interface for creating specifications, monitoring generation progress,
 and managing your synthetic data workflows.
 
+An optional positional path scopes the dashboard to a single spec file or a
+directory of them: the Current Specification section becomes a browsable
+list of just that directory's *.yaml/*.yml files (n/p or the arrow keys to
+switch between them), and F2 still opens the unscoped specification browser
+alongside it.
+
 Features:
   - Interactive specification editor with validation
   - Real-time generation monitoring with progress bars
@@ -20,19 +35,35 @@ Features:
   - Settings and configuration management
   - Contextual help and keyboard shortcuts
 
+Running with stdout piped into another command (or with NO_COLOR set) prints
+a stripped ASCII dashboard snapshot instead of launching the interactive
+program; --plain forces this even on a real terminal.
+
 Examples:
   # Launch TUI
   fauxfoundry tui
 
   # Launch TUI with specific specification
-  fauxfoundry tui --spec customer.yaml`,
-	RunE: runTUI,
-}
+  fauxfoundry tui --spec customer.yaml
 
-func init() {
-	tuiCmd.Flags().StringVarP(&specFile, "spec", "s", "", "load specific specification file")
-}
+  # Launch TUI scoped to a single spec, or every spec in a directory
+  fauxfoundry tui customer.yaml
+  fauxfoundry tui specs/
+
+  # Print a plain-text dashboard snapshot for scripts/CI
+  fauxfoundry tui --plain`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopePath := specFile
+			if len(args) > 0 {
+				scopePath = args[0]
+			}
+			return tui.Run(scopePath, app.Config.NoTermCache, plain)
+		},
+	}
+
+	cmd.Flags().StringVarP(&specFile, "spec", "s", "", "load specific specification file")
+	cmd.Flags().BoolVar(&plain, "plain", false, "render a stripped ASCII dashboard snapshot instead of the interactive TUI (also triggered automatically when stdout isn't a terminal, or NO_COLOR is set)")
 
-func runTUI(cmd *cobra.Command, args []string) error {
-	return tui.Run(specFile)
+	return cmd
 }
@@ -3,18 +3,30 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
+
+	"github.com/copyleftdev/faux-foundry/internal/format"
 	"github.com/copyleftdev/faux-foundry/internal/spec"
 )
 
-// validateCmd represents the validate command
-var validateCmd = &cobra.Command{
-	Use:   "validate [spec-file]",
-	Short: "Validate YAML specifications",
-	Long: `Validate one or more YAML specification files for syntax and semantic correctness.
+// newValidateCmd builds the validate subcommand, closing over app instead of
+// reading package globals.
+func newValidateCmd(app *App) *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "validate [spec-file|directory]...",
+		Short: "Validate YAML specifications",
+		Long: `Validate one or more YAML specification files for syntax and semantic correctness.
 This command checks that the specification is well-formed, all required fields are present,
-and field constraints are valid.
+and field constraints are valid. A spec's extends, imports, and field $ref directives are
+resolved before validation runs, against the spec's own directory.
+
+A directory argument validates the transitive closure instead: every *.yaml/*.yml file
+directly in it, each composed against that directory as the root extends/imports/$ref
+resolve relative to.
 
 Examples:
   # Validate a single specification
@@ -23,90 +35,165 @@ Examples:
   # Validate multiple specifications
   fauxfoundry validate customer.yaml products.yaml orders.yaml
 
+  # Validate every spec in a directory, resolving extends/imports/$ref against it
+  fauxfoundry validate specs/
+
   # Validate with dry-run (same as validate)
   fauxfoundry validate --dry-run customer.yaml`,
-	Args: cobra.MinimumNArgs(1),
-	RunE: runValidate,
-}
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.runValidate(cmd, args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "same as validate (included for consistency)")
 
-func init() {
-	validateCmd.Flags().BoolVar(&dryRun, "dry-run", false, "same as validate (included for consistency)")
+	return cmd
 }
 
-func runValidate(cmd *cobra.Command, args []string) error {
+func (a *App) runValidate(cmd *cobra.Command, args []string) error {
 	var hasErrors bool
-	
-	for _, specPath := range args {
-		if err := validateSingleSpec(specPath); err != nil {
+
+	specPaths, err := expandValidateArgs(args)
+	if err != nil {
+		return err
+	}
+
+	for _, specPath := range specPaths {
+		doc, diags, err := a.validateSingleSpec(specPath)
+		if err != nil {
 			hasErrors = true
-			if !IsQuiet() {
-				fmt.Fprintf(os.Stderr, "❌ %s: %v\n", specPath, err)
+			a.recordActivity("spec_validation_failed", fmt.Sprintf("%s: %v", specPath, err))
+			if !a.Config.Quiet {
+				fmt.Fprintf(a.Stderr, "❌ %s: %v\n", specPath, err)
 			}
+			continue
+		}
+
+		if len(diags) == 0 {
+			a.recordActivity("spec_validated", specPath)
+			if !a.Config.Quiet {
+				fmt.Fprintf(a.Stdout, "✅ %s: valid\n", specPath)
+			}
+			continue
+		}
+
+		if diags.HasErrors() {
+			hasErrors = true
+			a.recordActivity("spec_validation_failed", specPath)
 		} else {
-			if !IsQuiet() {
-				fmt.Printf("✅ %s: valid\n", specPath)
+			a.recordActivity("spec_validated", specPath)
+		}
+
+		if !a.Config.Quiet {
+			// doc.Source is whatever diagnostic ranges were actually
+			// computed against: the file itself for a plain spec, or the
+			// merged-and-remarshaled result for a composed one.
+			sources := map[string][]byte{specPath: doc.Source}
+			for _, d := range diags {
+				fmt.Fprint(a.Stderr, format.Diagnostic(d, sources, 80, !a.Config.NoColor))
 			}
 		}
 	}
-	
+
 	if hasErrors {
 		return fmt.Errorf("validation failed for one or more specifications")
 	}
-	
-	if !IsQuiet() {
-		fmt.Printf("\n🎉 All specifications are valid!\n")
+
+	if !a.Config.Quiet {
+		fmt.Fprintf(a.Stdout, "\n🎉 All specifications are valid!\n")
 	}
-	
+
 	return nil
 }
 
-func validateSingleSpec(specPath string) error {
-	// Check if file exists
+// expandValidateArgs turns args (a mix of spec files and directories) into
+// the flat list of spec files to validate, expanding each directory into
+// every *.yaml/*.yml file directly inside it, sorted for stable output.
+func expandValidateArgs(args []string) ([]string, error) {
+	var specPaths []string
+
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			// Let validateSingleSpec report the missing-file error per
+			// path, consistent with how it already handles bad specs.
+			specPaths = append(specPaths, arg)
+			continue
+		}
+		if !info.IsDir() {
+			specPaths = append(specPaths, arg)
+			continue
+		}
+
+		matches, err := filepath.Glob(filepath.Join(arg, "*.yaml"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", arg, err)
+		}
+		ymlMatches, err := filepath.Glob(filepath.Join(arg, "*.yml"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", arg, err)
+		}
+		specPaths = append(specPaths, matches...)
+		specPaths = append(specPaths, ymlMatches...)
+	}
+
+	return specPaths, nil
+}
+
+// validateSingleSpec loads and validates specPath, collecting every
+// diagnostic found instead of failing fast on the first one. The returned
+// error is reserved for problems that prevent validation from running at
+// all (a missing file or unparseable YAML); validation findings are
+// returned as Diagnostics even when they include errors. Loading goes
+// through a spec.Loader rooted at specPath's own directory, so a spec's
+// extends, imports, and field $ref directives resolve relative to it.
+func (a *App) validateSingleSpec(specPath string) (*spec.Document, spec.Diagnostics, error) {
 	if !fileExists(specPath) {
-		return fmt.Errorf("file not found")
+		return nil, nil, fmt.Errorf("file not found")
 	}
-	
-	// Load specification
-	specification, err := spec.LoadFromFile(specPath)
+
+	loader := spec.NewLoader(filepath.Dir(specPath))
+	doc, err := loader.Load(specPath)
 	if err != nil {
-		return fmt.Errorf("failed to parse: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse: %w", err)
 	}
-	
-	// Validate specification
-	if err := spec.Validate(specification); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+
+	diags := spec.ValidateDocument(doc)
+	if diags.HasErrors() {
+		return doc, diags, nil
 	}
-	
-	// If verbose, show details
-	if IsVerbose() {
-		fmt.Printf("  Domain: %s\n", specification.Dataset.Domain)
-		fmt.Printf("  Fields: %d\n", len(specification.Dataset.Fields))
-		fmt.Printf("  Target records: %d\n", specification.Dataset.Count)
-		fmt.Printf("  Model: %s\n", specification.Model.Name)
-		fmt.Printf("  Endpoint: %s\n", specification.Model.Endpoint)
-		fmt.Printf("  Batch size: %d\n", specification.Model.BatchSize)
-		fmt.Printf("  Temperature: %.2f\n", specification.Model.Temperature)
-		
+
+	if a.Config.Verbose {
+		specification := doc.Spec
+		fmt.Fprintf(a.Stdout, "  Domain: %s\n", specification.Dataset.Domain)
+		fmt.Fprintf(a.Stdout, "  Fields: %d\n", len(specification.Dataset.Fields))
+		fmt.Fprintf(a.Stdout, "  Target records: %d\n", specification.Dataset.Count)
+		fmt.Fprintf(a.Stdout, "  Model: %s\n", specification.Model.Name)
+		fmt.Fprintf(a.Stdout, "  Endpoint: %s\n", specification.Model.Endpoint)
+		fmt.Fprintf(a.Stdout, "  Batch size: %d\n", specification.Model.BatchSize)
+		fmt.Fprintf(a.Stdout, "  Temperature: %.2f\n", specification.Model.Temperature)
+
 		if len(specification.Dataset.Fields) > 0 {
-			fmt.Printf("  Field details:\n")
+			fmt.Fprintf(a.Stdout, "  Field details:\n")
 			for _, field := range specification.Dataset.Fields {
-				fmt.Printf("    - %s (%s)", field.Name, field.Type)
+				fmt.Fprintf(a.Stdout, "    - %s (%s)", field.Name, field.Type)
 				if field.Required {
-					fmt.Printf(" [required]")
+					fmt.Fprintf(a.Stdout, " [required]")
 				}
 				if field.Pattern != "" {
-					fmt.Printf(" pattern: %s", field.Pattern)
+					fmt.Fprintf(a.Stdout, " pattern: %s", field.Pattern)
 				}
 				if len(field.Range) == 2 {
-					fmt.Printf(" range: [%d, %d]", field.Range[0], field.Range[1])
+					fmt.Fprintf(a.Stdout, " range: [%d, %d]", field.Range[0], field.Range[1])
 				}
 				if len(field.Values) > 0 {
-					fmt.Printf(" values: %v", field.Values)
+					fmt.Fprintf(a.Stdout, " values: %v", field.Values)
 				}
-				fmt.Println()
+				fmt.Fprintln(a.Stdout)
 			}
 		}
 	}
-	
-	return nil
+
+	return doc, diags, nil
 }
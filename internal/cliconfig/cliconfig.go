@@ -0,0 +1,193 @@
+// Package cliconfig resolves FauxFoundry's layered configuration: command
+// flags, FAUXFOUNDRY_* environment variables, named profiles, and
+// .fauxfoundry.yaml config files, in that order of precedence, falling back
+// to built-in literals when nothing else supplies a value.
+package cliconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Source identifies which configuration layer supplied an effective value.
+type Source string
+
+const (
+	SourceFlag    Source = "flag"
+	SourceEnv     Source = "env"
+	SourceProfile Source = "profile"
+	SourceFile    Source = "file"
+	SourceBuiltin Source = "builtin"
+)
+
+// Built-in fallback values, used when no flag, env var, profile, or config
+// file supplies one. These are the literals that used to be hard-coded in
+// spec.setDefaults.
+const (
+	BuiltinEndpoint    = "http://localhost:11434"
+	BuiltinModelName   = "llama3.1:8b"
+	BuiltinBatchSize   = 32
+	BuiltinTemperature = 0.7
+	BuiltinTimeout     = "30s"
+	BuiltinProvider    = "ollama"
+)
+
+// Model holds the resolved Model defaults, plus which layer supplied each
+// one (keyed by "model.<field>") so callers like `fauxfoundry doctor` can
+// report it.
+type Model struct {
+	Endpoint    string
+	Name        string
+	BatchSize   int
+	Temperature float64
+	Timeout     string
+	Provider    string
+
+	Sources map[string]Source
+}
+
+// Options controls how Load resolves configuration.
+type Options struct {
+	// CfgFile, if set, is read in addition to (and on top of) the default
+	// search path below.
+	CfgFile string
+	// Profile selects the profiles.<name> block to prefer over the
+	// top-level config file values. Falls back to FAUXFOUNDRY_PROFILE when
+	// empty.
+	Profile string
+	// Flags, if set, is consulted first via Changed() for each field.
+	Flags *cobra.Command
+}
+
+// Load searches, in order, $HOME/.fauxfoundry.yaml, $XDG_CONFIG_HOME/fauxfoundry/config.yaml,
+// and ./.fauxfoundry.yaml (each optional), merges opts.CfgFile on top if
+// given, and resolves the effective Model defaults with precedence
+// flag > env > profile > config file > built-in.
+func Load(opts Options) (*Model, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetEnvPrefix("fauxfoundry")
+	v.AutomaticEnv()
+
+	var candidates []string
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, ".fauxfoundry.yaml"))
+	}
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		candidates = append(candidates, filepath.Join(xdg, "fauxfoundry", "config.yaml"))
+	}
+	candidates = append(candidates, ".fauxfoundry.yaml")
+
+	// The first candidate that exists is read; the rest are skipped.
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+		break
+	}
+
+	if opts.CfgFile != "" {
+		v.SetConfigFile(opts.CfgFile)
+		if err := v.MergeInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file %s: %w", opts.CfgFile, err)
+		}
+	}
+
+	profile := opts.Profile
+	if profile == "" {
+		profile = os.Getenv("FAUXFOUNDRY_PROFILE")
+	}
+
+	m := &Model{Sources: map[string]Source{}}
+
+	m.Endpoint, m.Sources["model.endpoint"] = resolveString(v, opts.Flags, "model-endpoint", "FAUXFOUNDRY_MODEL_ENDPOINT", profile, "model.endpoint", BuiltinEndpoint)
+	m.Name, m.Sources["model.name"] = resolveString(v, opts.Flags, "model-name", "FAUXFOUNDRY_MODEL_NAME", profile, "model.name", BuiltinModelName)
+	m.Timeout, m.Sources["model.timeout"] = resolveString(v, opts.Flags, "model-timeout", "FAUXFOUNDRY_MODEL_TIMEOUT", profile, "model.timeout", BuiltinTimeout)
+	m.BatchSize, m.Sources["model.batch_size"] = resolveInt(v, opts.Flags, "model-batch-size", "FAUXFOUNDRY_MODEL_BATCH_SIZE", profile, "model.batch_size", BuiltinBatchSize)
+	m.Temperature, m.Sources["model.temperature"] = resolveFloat(v, opts.Flags, "model-temperature", "FAUXFOUNDRY_MODEL_TEMPERATURE", profile, "model.temperature", BuiltinTemperature)
+	m.Provider, m.Sources["model.provider"] = resolveString(v, opts.Flags, "model-provider", "FAUXFOUNDRY_MODEL_PROVIDER", profile, "model.provider", BuiltinProvider)
+
+	return m, nil
+}
+
+// flagChanged reports whether cmd has an explicitly-set flag named name.
+func flagChanged(cmd *cobra.Command, name string) (string, bool) {
+	if cmd == nil {
+		return "", false
+	}
+	f := cmd.Flags().Lookup(name)
+	if f == nil || !f.Changed {
+		return "", false
+	}
+	return f.Value.String(), true
+}
+
+func resolveString(v *viper.Viper, cmd *cobra.Command, flagName, envKey, profile, key, builtin string) (string, Source) {
+	if raw, ok := flagChanged(cmd, flagName); ok {
+		return raw, SourceFlag
+	}
+	if raw, ok := os.LookupEnv(envKey); ok && raw != "" {
+		return raw, SourceEnv
+	}
+	if profile != "" {
+		if pk := "profiles." + profile + "." + key; v.IsSet(pk) {
+			return v.GetString(pk), SourceProfile
+		}
+	}
+	if v.IsSet(key) {
+		return v.GetString(key), SourceFile
+	}
+	return builtin, SourceBuiltin
+}
+
+func resolveInt(v *viper.Viper, cmd *cobra.Command, flagName, envKey, profile, key string, builtin int) (int, Source) {
+	if raw, ok := flagChanged(cmd, flagName); ok {
+		if n, err := parseInt(raw); err == nil {
+			return n, SourceFlag
+		}
+	}
+	if raw, ok := os.LookupEnv(envKey); ok && raw != "" {
+		if n, err := parseInt(raw); err == nil {
+			return n, SourceEnv
+		}
+	}
+	if profile != "" {
+		if pk := "profiles." + profile + "." + key; v.IsSet(pk) {
+			return v.GetInt(pk), SourceProfile
+		}
+	}
+	if v.IsSet(key) {
+		return v.GetInt(key), SourceFile
+	}
+	return builtin, SourceBuiltin
+}
+
+func resolveFloat(v *viper.Viper, cmd *cobra.Command, flagName, envKey, profile, key string, builtin float64) (float64, Source) {
+	if raw, ok := flagChanged(cmd, flagName); ok {
+		if f, err := parseFloat(raw); err == nil {
+			return f, SourceFlag
+		}
+	}
+	if raw, ok := os.LookupEnv(envKey); ok && raw != "" {
+		if f, err := parseFloat(raw); err == nil {
+			return f, SourceEnv
+		}
+	}
+	if profile != "" {
+		if pk := "profiles." + profile + "." + key; v.IsSet(pk) {
+			return v.GetFloat64(pk), SourceProfile
+		}
+	}
+	if v.IsSet(key) {
+		return v.GetFloat64(key), SourceFile
+	}
+	return builtin, SourceBuiltin
+}
@@ -0,0 +1,11 @@
+package cliconfig
+
+import "strconv"
+
+func parseInt(raw string) (int, error) {
+	return strconv.Atoi(raw)
+}
+
+func parseFloat(raw string) (float64, error) {
+	return strconv.ParseFloat(raw, 64)
+}
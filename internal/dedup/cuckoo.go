@@ -0,0 +1,169 @@
+package dedup
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// cuckooBucketSize is the number of fingerprint slots per bucket.
+const cuckooBucketSize = 4
+
+// cuckooFingerprintBits is the width of the fingerprint stored per entry.
+const cuckooFingerprintBits = 12
+
+// cuckooMaxFingerprint masks a hash down to cuckooFingerprintBits bits. 0 is
+// reserved to mean "empty slot", so fingerprints that hash to 0 are bumped
+// to 1.
+const cuckooMaxFingerprint = (1 << cuckooFingerprintBits) - 1
+
+// cuckooMaxKicks bounds how many times Insert will evict and relocate an
+// existing fingerprint before giving up and reporting the filter full.
+const cuckooMaxKicks = 500
+
+// cuckooFilter is an in-process Cuckoo filter: a probabilistic set with
+// O(1) insert/lookup/delete and support for relocating entries (partial-key
+// cuckoo hashing) without rehashing the original key, unlike a classic
+// cuckoo hash table.
+type cuckooFilter struct {
+	buckets [][cuckooBucketSize]uint16
+	mask    uint64
+}
+
+// newCuckooFilter sizes a filter to hold roughly capacity entries at a
+// comfortable load factor, rounding the bucket count up to a power of two
+// so indices can be masked instead of modded.
+func newCuckooFilter(capacity int) *cuckooFilter {
+	numBuckets := nextPowerOfTwo((capacity + cuckooBucketSize - 1) / cuckooBucketSize)
+	if numBuckets < 1 {
+		numBuckets = 1
+	}
+	return &cuckooFilter{
+		buckets: make([][cuckooBucketSize]uint16, numBuckets),
+		mask:    uint64(numBuckets - 1),
+	}
+}
+
+// locations computes a hash's two candidate bucket indices and its
+// fingerprint. The second index is derived from the first XORed with a hash
+// of the fingerprint alone (partial-key cuckoo hashing), so an entry can be
+// relocated between its two buckets using only its fingerprint — the
+// original key never needs to be rehashed.
+func (f *cuckooFilter) locations(hash string) (i1, i2 uint64, fp uint16) {
+	h := fnvHash64(hash)
+	fp = uint16(h & cuckooMaxFingerprint)
+	if fp == 0 {
+		fp = 1
+	}
+	i1 = h & f.mask
+	i2 = (i1 ^ fnvHash64Fingerprint(fp)) & f.mask
+	return i1, i2, fp
+}
+
+// altLocation returns the other candidate bucket for a fingerprint given one
+// of its current buckets, via the same partial-key relationship locations
+// uses to derive i2 from i1.
+func (f *cuckooFilter) altLocation(i uint64, fp uint16) uint64 {
+	return (i ^ fnvHash64Fingerprint(fp)) & f.mask
+}
+
+// Insert adds hash to the filter, relocating existing entries (cuckoo
+// kicks) when both candidate buckets are full. It reports false if the
+// filter could not make room within cuckooMaxKicks evictions.
+func (f *cuckooFilter) Insert(hash string) bool {
+	i1, i2, fp := f.locations(hash)
+
+	if f.insertAt(i1, fp) || f.insertAt(i2, fp) {
+		return true
+	}
+
+	i := i1
+	if rand.Intn(2) == 1 {
+		i = i2
+	}
+
+	for kick := 0; kick < cuckooMaxKicks; kick++ {
+		slot := rand.Intn(cuckooBucketSize)
+		fp, f.buckets[i][slot] = f.buckets[i][slot], fp
+		i = f.altLocation(i, fp)
+
+		if f.insertAt(i, fp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lookup reports whether hash's fingerprint is present in either of its two
+// candidate buckets. Like any Cuckoo/Bloom filter it can false-positive, but
+// never false-negatives.
+func (f *cuckooFilter) Lookup(hash string) bool {
+	i1, i2, fp := f.locations(hash)
+	return f.bucketHas(i1, fp) || f.bucketHas(i2, fp)
+}
+
+// Delete removes hash's fingerprint from whichever candidate bucket holds
+// it, reporting whether anything was removed.
+func (f *cuckooFilter) Delete(hash string) bool {
+	i1, i2, fp := f.locations(hash)
+	return f.removeAt(i1, fp) || f.removeAt(i2, fp)
+}
+
+func (f *cuckooFilter) insertAt(i uint64, fp uint16) bool {
+	bucket := &f.buckets[i]
+	for s, v := range bucket {
+		if v == 0 {
+			bucket[s] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (f *cuckooFilter) bucketHas(i uint64, fp uint16) bool {
+	bucket := &f.buckets[i]
+	for _, v := range bucket {
+		if v == fp {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *cuckooFilter) removeAt(i uint64, fp uint16) bool {
+	bucket := &f.buckets[i]
+	for s, v := range bucket {
+		if v == fp {
+			bucket[s] = 0
+			return true
+		}
+	}
+	return false
+}
+
+// fnvHash64 hashes s with FNV-1a.
+func fnvHash64(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// fnvHash64Fingerprint hashes a fingerprint's two bytes with FNV-1a, used to
+// derive an entry's alternate bucket from its fingerprint alone.
+func fnvHash64Fingerprint(fp uint16) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(fp), byte(fp >> 8)})
+	return h.Sum64()
+}
+
+// nextPowerOfTwo returns the smallest power of two >= n (minimum 1).
+func nextPowerOfTwo(n int) int {
+	if n < 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
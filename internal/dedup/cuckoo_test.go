@@ -0,0 +1,66 @@
+package dedup
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCuckooFilterInsertAndLookup(t *testing.T) {
+	f := newCuckooFilter(1024)
+
+	hashes := []string{"hash-a", "hash-b", "hash-c"}
+	for _, h := range hashes {
+		if !f.Insert(h) {
+			t.Fatalf("Insert(%q) = false, want true", h)
+		}
+	}
+
+	for _, h := range hashes {
+		if !f.Lookup(h) {
+			t.Errorf("Lookup(%q) = false after Insert, want true", h)
+		}
+	}
+
+	if f.Lookup("never-inserted") {
+		t.Error("Lookup() reported a fingerprint that was never inserted (false positives are possible but vanishingly unlikely for this input)")
+	}
+}
+
+func TestCuckooFilterDelete(t *testing.T) {
+	f := newCuckooFilter(1024)
+
+	f.Insert("hash-a")
+	if !f.Delete("hash-a") {
+		t.Fatal("Delete() = false for a fingerprint that was inserted")
+	}
+	if f.Lookup("hash-a") {
+		t.Error("Lookup() still finds a fingerprint after Delete()")
+	}
+	if f.Delete("hash-a") {
+		t.Error("Delete() = true for a fingerprint that was already removed")
+	}
+}
+
+func TestCuckooFilterHandlesLoadNearCapacity(t *testing.T) {
+	const capacity = 2000
+	// Cuckoo filters can legitimately fail a kick sequence as load factor
+	// approaches 100%, so this only exercises a comfortable 70% load
+	// rather than the filter's absolute limit.
+	const count = capacity * 7 / 10
+	f := newCuckooFilter(capacity)
+
+	inserted := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		h := fmt.Sprintf("hash-%d", i)
+		if !f.Insert(h) {
+			t.Fatalf("Insert() reported full after only %d entries (capacity %d)", i, capacity)
+		}
+		inserted = append(inserted, h)
+	}
+
+	for _, h := range inserted {
+		if !f.Lookup(h) {
+			t.Fatalf("Lookup(%q) = false, want true after successful Insert", h)
+		}
+	}
+}
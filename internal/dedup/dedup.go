@@ -10,30 +10,103 @@ import (
 	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
-// Deduplicator handles record deduplication using canonical hashing
+// Deduplicator handles record deduplication using canonical hashing, with an
+// optional MinHash/LSH near-duplicate mode layered on top. The set of seen
+// hashes lives behind the pluggable Store interface, so a long-running
+// generation job can persist and resume it instead of losing it to an
+// in-memory map.
 type Deduplicator struct {
-	seen map[string]bool
-	duplicateCount int
+	store              Store
+	duplicateCount     int
+	nearDuplicateCount int
+	nearDup            *nearDuplicateIndex
 }
 
-// NewDeduplicator creates a new deduplicator
+// NewDeduplicator creates a new deduplicator using exact SHA-256 matching
+// only, backed by an in-memory store.
 func NewDeduplicator() *Deduplicator {
 	return &Deduplicator{
-		seen: make(map[string]bool),
+		store: newMemStore(),
 	}
 }
 
-// IsUnique checks if a record is unique and adds it to the seen set
-func (d *Deduplicator) IsUnique(record types.Record) bool {
+// NewDeduplicatorWithStore creates a deduplicator backed by store instead of
+// the default in-memory map, e.g. a BoltStore so a generation job's seen-set
+// survives a pause/resume.
+func NewDeduplicatorWithStore(store Store) *Deduplicator {
+	return &Deduplicator{store: store}
+}
+
+// NewNearDuplicateDeduplicator creates a deduplicator that, in addition to
+// exact matching, flags near-duplicates: records whose concatenated field
+// values are at least threshold-similar (estimated Jaccard similarity) to a
+// record already seen. threshold and numHashes default to 0.8 and 128 when
+// <= 0; numHashes is split into LSH bands/rows that best approximate
+// threshold. This catches LLM outputs that differ only in whitespace,
+// ordering, or trivial rewording, which the exact hash misses.
+func NewNearDuplicateDeduplicator(threshold float64, numHashes int) *Deduplicator {
+	return &Deduplicator{
+		store:   newMemStore(),
+		nearDup: newNearDuplicateIndex(threshold, numHashes),
+	}
+}
+
+// NewNearDuplicateDeduplicatorWithStore combines NewDeduplicatorWithStore and
+// NewNearDuplicateDeduplicator: exact matches are checked against store,
+// while near-duplicate signatures stay in the in-process LSH index.
+func NewNearDuplicateDeduplicatorWithStore(store Store, threshold float64, numHashes int) *Deduplicator {
+	return &Deduplicator{
+		store:   store,
+		nearDup: newNearDuplicateIndex(threshold, numHashes),
+	}
+}
+
+// IsNovel checks whether record is unique, adds it to the seen set, and
+// returns the hashes of any matching records. A record is flagged as not
+// novel either because its canonical hash was already seen, or — when
+// near-duplicate detection is enabled — because its MinHash signature
+// shares an LSH band with a previously inserted record.
+func (d *Deduplicator) IsNovel(record types.Record) (bool, []string) {
 	hash := d.canonicalHash(record)
-	
-	if d.seen[hash] {
+
+	seen, err := d.store.Contains(hash)
+	if err != nil {
+		// The store couldn't confirm novelty (e.g. a Bolt read failed) —
+		// fail safe and treat the record as a duplicate rather than risk
+		// emitting one the store never recorded.
 		d.duplicateCount++
-		return false
+		return false, nil
 	}
-	
-	d.seen[hash] = true
-	return true
+	if seen {
+		d.duplicateCount++
+		return false, []string{hash}
+	}
+	if err := d.store.Insert(hash); err != nil {
+		d.duplicateCount++
+		return false, nil
+	}
+
+	if d.nearDup == nil {
+		return true, nil
+	}
+
+	sig := d.nearDup.signature(d.shingles(record))
+	similarTo := d.nearDup.find(sig)
+	d.nearDup.insert(hash, sig)
+
+	if len(similarTo) > 0 {
+		d.nearDuplicateCount++
+		return false, similarTo
+	}
+
+	return true, nil
+}
+
+// IsUnique checks if a record is unique and adds it to the seen set,
+// discarding the match hashes IsNovel would otherwise return.
+func (d *Deduplicator) IsUnique(record types.Record) bool {
+	novel, _ := d.IsNovel(record)
+	return novel
 }
 
 // FilterUnique filters a slice of records to only include unique ones
@@ -56,13 +129,27 @@ func (d *Deduplicator) GetDuplicateCount() int {
 
 // GetUniqueCount returns the number of unique records seen
 func (d *Deduplicator) GetUniqueCount() int {
-	return len(d.seen)
+	// Near-duplicates still have their exact hash recorded in the store,
+	// so they are subtracted back out here.
+	return d.store.Len() - d.nearDuplicateCount
+}
+
+// GetNearDuplicateCount returns the number of near-duplicates encountered.
+// It is always zero when near-duplicate detection was not enabled.
+func (d *Deduplicator) GetNearDuplicateCount() int {
+	return d.nearDuplicateCount
 }
 
 // Reset clears the deduplicator state
 func (d *Deduplicator) Reset() {
-	d.seen = make(map[string]bool)
+	// Best-effort: the in-memory store never errors here, and callers
+	// relying on a persistent store's Clear should check it directly.
+	_ = d.store.Clear()
 	d.duplicateCount = 0
+	d.nearDuplicateCount = 0
+	if d.nearDup != nil {
+		d.nearDup.reset()
+	}
 }
 
 // canonicalHash creates a canonical hash of a record
@@ -158,6 +245,58 @@ func (d *Deduplicator) isComparableArray(arr []interface{}) bool {
 	return true
 }
 
+// shingles tokenizes the record's canonicalized field values into
+// overlapping k-word shingles and hashes each one, producing the set
+// consumed by the MinHash signature. Records shorter than a single shingle
+// fall back to one shingle over all their words.
+func (d *Deduplicator) shingles(record types.Record) []uint64 {
+	words := strings.Fields(flattenText(d.canonicalize(record)))
+	k := d.nearDup.shingleSize
+
+	if len(words) <= k {
+		return []uint64{hashString(strings.Join(words, " "))}
+	}
+
+	shingles := make([]uint64, 0, len(words)-k+1)
+	for i := 0; i+k <= len(words); i++ {
+		shingles = append(shingles, hashString(strings.Join(words[i:i+k], " ")))
+	}
+	return shingles
+}
+
+// flattenText walks a canonicalized value depth-first and joins every
+// scalar it finds into a single space-separated string, in map-key order so
+// the result is stable across runs.
+func flattenText(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		parts := make([]string, 0, len(keys))
+		for _, key := range keys {
+			parts = append(parts, flattenText(v[key]))
+		}
+		return strings.Join(parts, " ")
+
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = flattenText(item)
+		}
+		return strings.Join(parts, " ")
+
+	case nil:
+		return ""
+
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
 // BatchDeduplicator handles deduplication for batches of records
 type BatchDeduplicator struct {
 	*Deduplicator
@@ -172,6 +311,26 @@ func NewBatchDeduplicator(batchSize int) *BatchDeduplicator {
 	}
 }
 
+// NewNearDuplicateBatchDeduplicator creates a batch deduplicator with
+// MinHash/LSH near-duplicate detection enabled; see
+// NewNearDuplicateDeduplicator for the threshold/numHashes semantics.
+func NewNearDuplicateBatchDeduplicator(batchSize int, threshold float64, numHashes int) *BatchDeduplicator {
+	return &BatchDeduplicator{
+		Deduplicator: NewNearDuplicateDeduplicator(threshold, numHashes),
+		batchSize:    batchSize,
+	}
+}
+
+// NewBatchDeduplicatorWithStore creates a batch deduplicator backed by
+// store, e.g. a BoltStore so a paused generation job can resume its
+// seen-set instead of re-hashing every record it already emitted.
+func NewBatchDeduplicatorWithStore(store Store, batchSize int) *BatchDeduplicator {
+	return &BatchDeduplicator{
+		Deduplicator: NewDeduplicatorWithStore(store),
+		batchSize:    batchSize,
+	}
+}
+
 // ProcessBatch processes a batch of records and returns unique ones
 func (bd *BatchDeduplicator) ProcessBatch(records []types.Record) []types.Record {
 	return bd.FilterUnique(records)
@@ -179,28 +338,35 @@ func (bd *BatchDeduplicator) ProcessBatch(records []types.Record) []types.Record
 
 // GetStats returns deduplication statistics
 func (bd *BatchDeduplicator) GetStats() DeduplicationStats {
+	duplicates := bd.GetDuplicateCount()
+	nearDuplicates := bd.GetNearDuplicateCount()
+	total := bd.GetUniqueCount() + duplicates + nearDuplicates
+
 	return DeduplicationStats{
-		UniqueRecords:    bd.GetUniqueCount(),
-		DuplicateRecords: bd.GetDuplicateCount(),
-		TotalProcessed:   bd.GetUniqueCount() + bd.GetDuplicateCount(),
-		DeduplicationRate: float64(bd.GetDuplicateCount()) / float64(bd.GetUniqueCount()+bd.GetDuplicateCount()),
+		UniqueRecords:        bd.GetUniqueCount(),
+		DuplicateRecords:     duplicates,
+		NearDuplicateRecords: nearDuplicates,
+		TotalProcessed:       total,
+		DeduplicationRate:    float64(duplicates+nearDuplicates) / float64(total),
 	}
 }
 
 // DeduplicationStats contains statistics about the deduplication process
 type DeduplicationStats struct {
-	UniqueRecords     int     `json:"unique_records"`
-	DuplicateRecords  int     `json:"duplicate_records"`
-	TotalProcessed    int     `json:"total_processed"`
-	DeduplicationRate float64 `json:"deduplication_rate"`
+	UniqueRecords        int     `json:"unique_records"`
+	DuplicateRecords     int     `json:"duplicate_records"`
+	NearDuplicateRecords int     `json:"near_duplicate_records"`
+	TotalProcessed       int     `json:"total_processed"`
+	DeduplicationRate    float64 `json:"deduplication_rate"`
 }
 
 // String returns a string representation of the stats
 func (s DeduplicationStats) String() string {
 	return fmt.Sprintf(
-		"Unique: %d, Duplicates: %d, Total: %d, Rate: %.2f%%",
+		"Unique: %d, Duplicates: %d, NearDuplicates: %d, Total: %d, Rate: %.2f%%",
 		s.UniqueRecords,
 		s.DuplicateRecords,
+		s.NearDuplicateRecords,
 		s.TotalProcessed,
 		s.DeduplicationRate*100,
 	)
@@ -0,0 +1,139 @@
+package dedup
+
+import (
+	"testing"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+func TestDeduplicatorExactMatch(t *testing.T) {
+	d := NewDeduplicator()
+
+	record := types.Record{"name": "Ada Lovelace", "age": float64(36)}
+
+	if novel, _ := d.IsNovel(record); !novel {
+		t.Fatal("first occurrence of record should be novel")
+	}
+
+	novel, matches := d.IsNovel(record)
+	if novel {
+		t.Fatal("repeated record should not be novel")
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected one matching hash, got %d", len(matches))
+	}
+
+	if got := d.GetDuplicateCount(); got != 1 {
+		t.Errorf("GetDuplicateCount() = %d, want 1", got)
+	}
+	if got := d.GetUniqueCount(); got != 1 {
+		t.Errorf("GetUniqueCount() = %d, want 1", got)
+	}
+}
+
+func TestDeduplicatorCanonicalizationIgnoresKeyOrderAndWhitespace(t *testing.T) {
+	d := NewDeduplicator()
+
+	first := types.Record{"name": "  Ada  ", "role": "mathematician"}
+	second := types.Record{"role": "mathematician", "name": "Ada"}
+
+	if novel, _ := d.IsNovel(first); !novel {
+		t.Fatal("first record should be novel")
+	}
+	if novel, _ := d.IsNovel(second); novel {
+		t.Fatal("record differing only in key order and whitespace should be treated as a duplicate")
+	}
+}
+
+func TestDeduplicatorReset(t *testing.T) {
+	d := NewDeduplicator()
+	record := types.Record{"name": "Ada"}
+
+	d.IsNovel(record)
+	d.IsNovel(record)
+	d.Reset()
+
+	if got := d.GetUniqueCount(); got != 0 {
+		t.Errorf("GetUniqueCount() after Reset() = %d, want 0", got)
+	}
+	if got := d.GetDuplicateCount(); got != 0 {
+		t.Errorf("GetDuplicateCount() after Reset() = %d, want 0", got)
+	}
+	if novel, _ := d.IsNovel(record); !novel {
+		t.Fatal("record should be novel again after Reset()")
+	}
+}
+
+func TestNearDuplicateDeduplicatorFlagsSimilarRecords(t *testing.T) {
+	d := NewNearDuplicateDeduplicator(0.7, 128)
+
+	original := types.Record{
+		"bio": "Ada Lovelace was an English mathematician and writer chiefly known for her work on Charles Babbage's Analytical Engine",
+	}
+	reworded := types.Record{
+		"bio": "Ada Lovelace was an English mathematician and writer chiefly known for her work on Charles Babbage's Analytical Engines",
+	}
+	unrelated := types.Record{
+		"bio": "The quick brown fox jumps over the lazy dog near the riverbank every single morning without fail",
+	}
+
+	if novel, _ := d.IsNovel(original); !novel {
+		t.Fatal("first record should be novel")
+	}
+
+	if novel, matches := d.IsNovel(reworded); novel {
+		t.Error("near-identical reworded record should be flagged as a near-duplicate")
+	} else if len(matches) == 0 {
+		t.Error("near-duplicate match should report the hash it collided with")
+	}
+
+	if got := d.GetNearDuplicateCount(); got != 1 {
+		t.Errorf("GetNearDuplicateCount() = %d, want 1", got)
+	}
+
+	if novel, _ := d.IsNovel(unrelated); !novel {
+		t.Error("unrelated record should not be flagged as a near-duplicate")
+	}
+}
+
+func TestBatchDeduplicatorProcessBatch(t *testing.T) {
+	bd := NewBatchDeduplicator(10)
+
+	records := []types.Record{
+		{"name": "Ada"},
+		{"name": "Ada"},
+		{"name": "Grace"},
+	}
+
+	unique := bd.ProcessBatch(records)
+	if len(unique) != 2 {
+		t.Fatalf("ProcessBatch() returned %d records, want 2", len(unique))
+	}
+
+	stats := bd.GetStats()
+	if stats.UniqueRecords != 2 {
+		t.Errorf("stats.UniqueRecords = %d, want 2", stats.UniqueRecords)
+	}
+	if stats.DuplicateRecords != 1 {
+		t.Errorf("stats.DuplicateRecords = %d, want 1", stats.DuplicateRecords)
+	}
+	if stats.TotalProcessed != 3 {
+		t.Errorf("stats.TotalProcessed = %d, want 3", stats.TotalProcessed)
+	}
+}
+
+func TestDeduplicatorWithStore(t *testing.T) {
+	store := newMemStore()
+	d := NewDeduplicatorWithStore(store)
+
+	record := types.Record{"name": "Ada"}
+	d.IsNovel(record)
+
+	seen, err := store.Contains(d.canonicalHash(record))
+	if err != nil {
+		t.Fatalf("store.Contains() error = %v", err)
+	}
+	if !seen {
+		t.Error("store should have recorded the hash inserted via the deduplicator")
+	}
+}
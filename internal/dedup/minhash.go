@@ -0,0 +1,171 @@
+package dedup
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+const (
+	defaultShingleSize = 5
+	defaultNumHashes   = 128
+	defaultThreshold   = 0.8
+)
+
+// minHashSignature is a record's MinHash sketch: one minimum hash value per
+// independent seed.
+type minHashSignature []uint64
+
+// nearDuplicateIndex is an LSH index over MinHash signatures. Signatures are
+// split into bands of rows contiguous hashes each; two records that agree on
+// every hash within at least one band are reported as near-duplicates.
+type nearDuplicateIndex struct {
+	shingleSize int
+	numHashes   int
+	bands       int
+	rows        int
+	seeds       []uint64
+	buckets     []map[uint64][]string // one bucket map per band, keyed by band signature hash
+	sigs        map[string]minHashSignature
+}
+
+// newNearDuplicateIndex builds an index whose (bands, rows) split of
+// numHashes best approximates the Jaccard threshold at which two records are
+// considered near-duplicates. threshold and numHashes fall back to 0.8 and
+// 128 when <= 0.
+func newNearDuplicateIndex(threshold float64, numHashes int) *nearDuplicateIndex {
+	if numHashes <= 0 {
+		numHashes = defaultNumHashes
+	}
+	if threshold <= 0 || threshold >= 1 {
+		threshold = defaultThreshold
+	}
+
+	bands, rows := bandsAndRows(numHashes, threshold)
+
+	idx := &nearDuplicateIndex{
+		shingleSize: defaultShingleSize,
+		numHashes:   numHashes,
+		bands:       bands,
+		rows:        rows,
+		seeds:       makeSeeds(numHashes),
+	}
+	idx.reset()
+	return idx
+}
+
+// reset discards every inserted signature, leaving the index empty.
+func (idx *nearDuplicateIndex) reset() {
+	buckets := make([]map[uint64][]string, idx.bands)
+	for i := range buckets {
+		buckets[i] = make(map[uint64][]string)
+	}
+	idx.buckets = buckets
+	idx.sigs = make(map[string]minHashSignature)
+}
+
+// signature computes the MinHash sketch of a shingle set: for each seed i,
+// the minimum over all shingles of hash(shingle) ^ seed_i.
+func (idx *nearDuplicateIndex) signature(shingles []uint64) minHashSignature {
+	sig := make(minHashSignature, idx.numHashes)
+	for i := range sig {
+		sig[i] = ^uint64(0)
+	}
+
+	for _, shingle := range shingles {
+		for i, seed := range idx.seeds {
+			if h := shingle ^ seed; h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+
+	return sig
+}
+
+// find returns the hashes of every previously inserted record whose
+// signature shares a band with sig.
+func (idx *nearDuplicateIndex) find(sig minHashSignature) []string {
+	seen := make(map[string]bool)
+	var matches []string
+
+	for band := 0; band < idx.bands; band++ {
+		key := idx.bandKey(sig, band)
+		for _, hash := range idx.buckets[band][key] {
+			if !seen[hash] {
+				seen[hash] = true
+				matches = append(matches, hash)
+			}
+		}
+	}
+
+	return matches
+}
+
+// insert adds a record's signature to every band bucket it falls into.
+func (idx *nearDuplicateIndex) insert(hash string, sig minHashSignature) {
+	idx.sigs[hash] = sig
+	for band := 0; band < idx.bands; band++ {
+		key := idx.bandKey(sig, band)
+		idx.buckets[band][key] = append(idx.buckets[band][key], hash)
+	}
+}
+
+// bandKey hashes the rows-length slice of sig belonging to band into a
+// single bucket key.
+func (idx *nearDuplicateIndex) bandKey(sig minHashSignature, band int) uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	start := band * idx.rows
+	for i := 0; i < idx.rows; i++ {
+		binary.LittleEndian.PutUint64(buf, sig[start+i])
+		h.Write(buf)
+	}
+	return h.Sum64()
+}
+
+// bandsAndRows picks the divisor pair (bands, rows) of numHashes whose LSH
+// threshold (1/bands)^(1/rows) comes closest to threshold.
+func bandsAndRows(numHashes int, threshold float64) (bands, rows int) {
+	bestBands, bestRows := numHashes, 1
+	bestDiff := math.MaxFloat64
+
+	for r := 1; r <= numHashes; r++ {
+		if numHashes%r != 0 {
+			continue
+		}
+		b := numHashes / r
+		t := math.Pow(1.0/float64(b), 1.0/float64(r))
+		if diff := math.Abs(t - threshold); diff < bestDiff {
+			bestDiff = diff
+			bestBands, bestRows = b, r
+		}
+	}
+
+	return bestBands, bestRows
+}
+
+// makeSeeds deterministically derives n independent 64-bit hash seeds via
+// splitmix64, so that signatures are reproducible across runs and processes.
+func makeSeeds(n int) []uint64 {
+	const golden = 0x9E3779B97F4A7C15
+
+	seeds := make([]uint64, n)
+	var state uint64
+	for i := range seeds {
+		state += golden
+		z := state
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		z = z ^ (z >> 31)
+		seeds[i] = z
+	}
+	return seeds
+}
+
+// hashString reduces a shingle's text to a single uint64 via FNV-1a.
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
@@ -0,0 +1,49 @@
+package dedup
+
+// Store persists the set of canonical record hashes a Deduplicator has
+// already seen. The default in-memory implementation loses that set when
+// the process exits; BoltStore (store_bolt.go) persists it so a paused
+// generation job can resume without re-hashing every prior record.
+type Store interface {
+	// Contains reports whether hash has already been recorded.
+	Contains(hash string) (bool, error)
+	// Insert records hash as seen.
+	Insert(hash string) error
+	// Len returns the number of hashes recorded.
+	Len() int
+	// Clear discards every recorded hash.
+	Clear() error
+	// Close releases any resources the store holds (e.g. an open file).
+	Close() error
+}
+
+// memStore is the default Store: a plain in-memory set with no persistence.
+type memStore struct {
+	seen map[string]bool
+}
+
+func newMemStore() *memStore {
+	return &memStore{seen: make(map[string]bool)}
+}
+
+func (s *memStore) Contains(hash string) (bool, error) {
+	return s.seen[hash], nil
+}
+
+func (s *memStore) Insert(hash string) error {
+	s.seen[hash] = true
+	return nil
+}
+
+func (s *memStore) Len() int {
+	return len(s.seen)
+}
+
+func (s *memStore) Clear() error {
+	s.seen = make(map[string]bool)
+	return nil
+}
+
+func (s *memStore) Close() error {
+	return nil
+}
@@ -0,0 +1,185 @@
+package dedup
+
+import (
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// hashesBucketName is the single Bolt bucket a BoltStore keeps its recorded
+// hashes in.
+var hashesBucketName = []byte("hashes")
+
+// BoltStore is a Store backed by a BoltDB (bbolt) file, fronted by an
+// in-process Cuckoo filter so repeated Contains checks during a long
+// generation run stay O(1) without a disk read for the common case of a
+// record that hasn't been seen before.
+type BoltStore struct {
+	db     *bolt.DB
+	filter *cuckooFilter
+	count  int
+}
+
+// Open opens (creating if necessary) a persistent Store at path. The Cuckoo
+// filter is rebuilt by scanning every hash already recorded in the Bolt
+// bucket, so a resumed generation job gets accelerated lookups immediately
+// instead of waiting for fresh inserts to repopulate the filter.
+func Open(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt store: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(hashesBucketName)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create hashes bucket: %w", err)
+	}
+
+	count := 0
+	if err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(hashesBucketName).ForEach(func(k, v []byte) error {
+			count++
+			return nil
+		})
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to count existing hashes: %w", err)
+	}
+
+	store := &BoltStore{db: db, filter: newCuckooFilter(maxInt(count*2, 1024))}
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(hashesBucketName).ForEach(func(k, v []byte) error {
+			store.filter.Insert(string(k))
+			store.count++
+			return nil
+		})
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to rebuild cuckoo filter: %w", err)
+	}
+
+	return store, nil
+}
+
+// Contains implements Store. A filter miss returns false without touching
+// disk; a filter hit is confirmed against Bolt, since the filter can
+// false-positive.
+func (s *BoltStore) Contains(hash string) (bool, error) {
+	if !s.filter.Lookup(hash) {
+		return false, nil
+	}
+
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		found = tx.Bucket(hashesBucketName).Get([]byte(hash)) != nil
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to read hash: %w", err)
+	}
+	return found, nil
+}
+
+// Insert implements Store. A hash already recorded is left as it is and not
+// counted again. When the filter cannot take the new fingerprint, it is
+// rebuilt at twice the recorded size so Contains never misses a hash.
+func (s *BoltStore) Insert(hash string) error {
+	existed := false
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(hashesBucketName)
+		if existed = bucket.Get([]byte(hash)) != nil; existed {
+			return nil
+		}
+		return bucket.Put([]byte(hash), []byte{1})
+	}); err != nil {
+		return fmt.Errorf("failed to persist hash: %w", err)
+	}
+	if existed {
+		return nil
+	}
+
+	s.count++
+	if !s.filter.Insert(hash) {
+		hashes, err := s.Snapshot()
+		if err != nil {
+			return fmt.Errorf("failed to rebuild cuckoo filter: %w", err)
+		}
+		s.filter = newCuckooFilter(len(hashes) * 2)
+		for _, h := range hashes {
+			s.filter.Insert(h)
+		}
+	}
+	return nil
+}
+
+// Len implements Store.
+func (s *BoltStore) Len() int {
+	return s.count
+}
+
+// Clear implements Store, deleting and recreating the hashes bucket.
+func (s *BoltStore) Clear() error {
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(hashesBucketName); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(hashesBucketName)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to clear bolt store: %w", err)
+	}
+
+	s.filter = newCuckooFilter(1024)
+	s.count = 0
+	return nil
+}
+
+// Close implements Store.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// Snapshot returns every hash currently recorded in the store, e.g. so a
+// paused generation job's seen-set can be captured without keeping the Bolt
+// file open.
+func (s *BoltStore) Snapshot() ([]string, error) {
+	var hashes []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(hashesBucketName).ForEach(func(k, v []byte) error {
+			hashes = append(hashes, string(k))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot store: %w", err)
+	}
+	return hashes, nil
+}
+
+// Merge inserts every hash recorded in other into s, so two stores
+// populated on different machines can be combined without re-hashing the
+// records that produced them.
+func (s *BoltStore) Merge(other *BoltStore) error {
+	hashes, err := other.Snapshot()
+	if err != nil {
+		return fmt.Errorf("failed to snapshot source store: %w", err)
+	}
+
+	for _, hash := range hashes {
+		if err := s.Insert(hash); err != nil {
+			return fmt.Errorf("failed to merge hash %q: %w", hash, err)
+		}
+	}
+	return nil
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
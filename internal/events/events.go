@@ -0,0 +1,133 @@
+// Package events publishes a generation job's lifecycle as structured
+// events (job.started, batch.completed, record.written, dedup.duplicate,
+// job.failed, job.completed) to a pluggable Sink, the same
+// URI-scheme-selected pluggable-destination pattern internal/output uses
+// for --sink, so an external dashboard or orchestrator can watch a
+// long-running job without polling the output file.
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Type identifies what happened in a generation job.
+type Type string
+
+const (
+	JobStarted     Type = "job.started"
+	BatchCompleted Type = "batch.completed"
+	RecordWritten  Type = "record.written"
+	DedupDuplicate Type = "dedup.duplicate"
+	JobFailed      Type = "job.failed"
+	JobCompleted   Type = "job.completed"
+)
+
+// Event is a single lifecycle notification, carrying the job's progress
+// snapshot at the moment it fired.
+type Event struct {
+	Type      Type            `json:"type"`
+	JobID     string          `json:"job_id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Status    types.JobStatus `json:"status"`
+	Progress  types.Progress  `json:"progress"`
+	Message   string          `json:"message,omitempty"`
+}
+
+// Sink is the adapter interface an event destination implements.
+// stdoutSink, webhookSink, and mqttSink are the built-in adapters;
+// RegisterSink lets a caller plug in anything else behind an --events URI
+// scheme of its own.
+type Sink interface {
+	Publish(ctx context.Context, event Event) error
+	Close() error
+}
+
+// SinkFactory builds a Sink from a parsed --events URI, e.g.
+// mqtt://broker:1883/?qos=1&retain=true or https://hooks.example.com/job.
+type SinkFactory func(uri *url.URL) (Sink, error)
+
+// sinkFactories maps an --events URI scheme to the factory that builds its
+// Sink. Populated with the built-in stdout, webhook (http/https), and MQTT
+// adapters; RegisterSink adds more.
+var sinkFactories = map[string]SinkFactory{
+	"stdout": newStdoutSink,
+	"http":   newWebhookSink,
+	"https":  newWebhookSink,
+	"mqtt":   newMQTTSink,
+	"mqtts":  newMQTTSink,
+}
+
+// RegisterSink adds (or overrides) the Sink factory for an --events URI
+// scheme. Call it from an init() before OpenSink runs.
+func RegisterSink(scheme string, factory SinkFactory) {
+	sinkFactories[scheme] = factory
+}
+
+// OpenSink dispatches uri's scheme (via sinkFactories) to build a Sink.
+func OpenSink(uri string) (Sink, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse events URI: %w", err)
+	}
+
+	factory, ok := sinkFactories[parsed.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported events scheme %q (want one of stdout, http, https, mqtt, mqtts, or a scheme registered via RegisterSink)", parsed.Scheme)
+	}
+
+	return factory(parsed)
+}
+
+// Bus publishes events to a single Sink, logging (rather than failing the
+// job on) a publish error - a dashboard being unreachable shouldn't turn an
+// otherwise-successful generation run into a failed one, the same
+// tolerance internal/cli's recordHistory already applies to the history
+// store.
+type Bus struct {
+	sink  Sink
+	jobID string
+	onErr func(event Event, err error)
+}
+
+// NewBus wraps sink in a Bus that stamps jobID onto every event it
+// publishes. onErr (may be nil) is called with any publish error instead
+// of Publish returning one.
+func NewBus(sink Sink, jobID string, onErr func(event Event, err error)) *Bus {
+	return &Bus{sink: sink, jobID: jobID, onErr: onErr}
+}
+
+// Publish stamps event's JobID/Timestamp and sends it to the underlying
+// Sink. A publish failure is reported via onErr, never returned, so a
+// caller never has to decide whether an events failure should abort
+// generation.
+func (b *Bus) Publish(ctx context.Context, eventType Type, status types.JobStatus, progress types.Progress, message string) {
+	if b == nil || b.sink == nil {
+		return
+	}
+
+	event := Event{
+		Type:      eventType,
+		JobID:     b.jobID,
+		Timestamp: time.Now(),
+		Status:    status,
+		Progress:  progress,
+		Message:   message,
+	}
+
+	if err := b.sink.Publish(ctx, event); err != nil && b.onErr != nil {
+		b.onErr(event, err)
+	}
+}
+
+// Close releases the underlying Sink. Safe to call on a nil Bus.
+func (b *Bus) Close() error {
+	if b == nil || b.sink == nil {
+		return nil
+	}
+	return b.sink.Close()
+}
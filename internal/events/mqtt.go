@@ -0,0 +1,88 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// mqttSink publishes each event to its own topic under
+// fauxfoundry/<job_id>/<event>, e.g. fauxfoundry/job_1700000000/batch.completed,
+// so a subscriber can watch a single job or a single event type with one
+// MQTT wildcard subscription instead of filtering a shared topic.
+type mqttSink struct {
+	client mqtt.Client
+	qos    byte
+	retain bool
+}
+
+// newMQTTSink builds an mqttSink from a "mqtt://broker:1883" (or
+// "mqtts://broker:8883") URI. ?qos=0|1|2 (default 0) and ?retain=true
+// (default false) are read from the query string, mirroring Flamenco
+// 3.5's configurable-QoS MQTT event bus.
+func newMQTTSink(uri *url.URL) (Sink, error) {
+	if uri.Host == "" {
+		return nil, fmt.Errorf("mqtt events URI %s is missing a broker host, e.g. mqtt://broker:1883", uri)
+	}
+
+	qos := 0
+	if v := uri.Query().Get("qos"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 || parsed > 2 {
+			return nil, fmt.Errorf("mqtt events URI %s has invalid qos %q (want 0, 1, or 2)", uri, v)
+		}
+		qos = parsed
+	}
+
+	retain := false
+	if v := uri.Query().Get("retain"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("mqtt events URI %s has invalid retain %q: %w", uri, v, err)
+		}
+		retain = parsed
+	}
+
+	scheme := "tcp"
+	if uri.Scheme == "mqtts" {
+		scheme = "ssl"
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(fmt.Sprintf("%s://%s", scheme, uri.Host)).
+		SetClientID(fmt.Sprintf("fauxfoundry-%d", time.Now().UnixNano())).
+		SetConnectTimeout(10 * time.Second)
+
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, fmt.Errorf("failed to connect to MQTT broker at %s: %w", uri.Host, token.Error())
+	}
+
+	return &mqttSink{client: client, qos: byte(qos), retain: retain}, nil
+}
+
+// Publish sends event to fauxfoundry/<job_id>/<event>.
+func (m *mqttSink) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event as JSON: %w", err)
+	}
+
+	topic := fmt.Sprintf("fauxfoundry/%s/%s", event.JobID, event.Type)
+	token := m.client.Publish(topic, m.qos, m.retain, data)
+	if token.Wait() && token.Error() != nil {
+		return fmt.Errorf("failed to publish event to %s: %w", topic, token.Error())
+	}
+	return nil
+}
+
+// Close disconnects the underlying MQTT client.
+func (m *mqttSink) Close() error {
+	m.client.Disconnect(250)
+	return nil
+}
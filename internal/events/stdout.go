@@ -0,0 +1,39 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+)
+
+// stdoutSink writes each event as a single JSON line to os.Stdout, for
+// piping a job's lifecycle straight into jq or a log collector. It writes
+// to the same stdout a plain (non --sink) `generate` run writes records
+// to, so combining --events stdout:// with an unset --output/--sink
+// (which also default to stdout) interleaves the two streams - pick a
+// file or message-bus --sink when using the stdout events sink.
+type stdoutSink struct {
+	w io.Writer
+}
+
+// newStdoutSink builds a stdoutSink. uri's host/path are ignored; only the
+// "stdout" scheme selects it.
+func newStdoutSink(uri *url.URL) (Sink, error) {
+	return &stdoutSink{w: os.Stdout}, nil
+}
+
+// Publish writes event as a single JSON line.
+func (s *stdoutSink) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event as JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(s.w, string(data))
+	return err
+}
+
+// Close is a no-op: stdoutSink doesn't own os.Stdout.
+func (s *stdoutSink) Close() error { return nil }
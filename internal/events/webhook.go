@@ -0,0 +1,60 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// webhookSink POSTs each event as a JSON body to a fixed URL, for wiring a
+// job's lifecycle into an orchestrator's webhook trigger (Airflow, n8n,
+// etc.) without that orchestrator having to poll.
+type webhookSink struct {
+	client *http.Client
+	url    string
+}
+
+// newWebhookSink builds a webhookSink from uri verbatim (an http:// or
+// https:// URI, registered under both schemes in sinkFactories). It
+// doesn't interpret uri's query string - a caller wanting headers or auth
+// baked in should encode them in the URL itself (e.g. a token query param)
+// the way a webhook provider's own docs typically recommend.
+func newWebhookSink(uri *url.URL) (Sink, error) {
+	return &webhookSink{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    uri.String(),
+	}, nil
+}
+
+// Publish POSTs event as a JSON body, treating any non-2xx response as a
+// failure.
+func (w *webhookSink) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event as JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to POST event to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close is a no-op: webhookSink holds no connection to release.
+func (w *webhookSink) Close() error { return nil }
@@ -0,0 +1,150 @@
+// Package format renders spec.Diagnostic values as human-readable,
+// source-annotated text, in the style of the snippet diagnostics tools like
+// Terraform print: a summary line, a file/line/column header, the source
+// line the diagnostic starts on with a caret/underline under the affected
+// columns, and a word-wrapped detail.
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+)
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiGray   = "\x1b[90m"
+)
+
+const defaultWidth = 80
+
+// Diagnostic renders a single diagnostic: a bold summary line, an
+// "on <file> line N, column M:" locator, the source line the Subject starts
+// on with a caret underline beneath the affected columns, and the detail
+// text wrapped to
+// width. sources maps a diagnostic's Subject.Filename to that file's raw
+// bytes; a diagnostic without a Subject (or one whose file isn't in
+// sources) renders without a source snippet. width <= 0 falls back to 80;
+// color disables ANSI escapes when false.
+func Diagnostic(d *spec.Diagnostic, sources map[string][]byte, width int, color bool) string {
+	if width <= 0 {
+		width = defaultWidth
+	}
+
+	var b strings.Builder
+
+	severityColor := ansiRed
+	if d.Severity == spec.SeverityWarning {
+		severityColor = ansiYellow
+	}
+
+	fmt.Fprintf(&b, "%s: %s\n", paint(color, severityColor+ansiBold, d.Severity.String()), paint(color, ansiBold, d.Summary))
+
+	if d.Subject != nil {
+		fmt.Fprintf(&b, "  on %s line %d, column %d:\n", d.Subject.Filename, d.Subject.Start.Line, d.Subject.Start.Column)
+
+		if snippet := sourceSnippet(d.Subject, sources[d.Subject.Filename], color); snippet != "" {
+			b.WriteString(snippet)
+		}
+	}
+
+	if d.Origin != "" {
+		fmt.Fprintf(&b, "  defined in %s\n", d.Origin)
+	}
+
+	if d.Detail != "" {
+		b.WriteString("\n")
+		for _, line := range wrapText(d.Detail, width) {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// sourceSnippet renders the line a Range starts on, prefixed with a gutter
+// showing the line number, followed by a caret/underline under the
+// affected columns. A Range spanning several lines shows only its first
+// line, and its underline is a single caret unless End.Column lies past
+// Start.Column.
+func sourceSnippet(r *spec.Range, source []byte, color bool) string {
+	if len(source) == 0 {
+		return ""
+	}
+
+	line := sourceLine(source, r.Start.Line)
+	if line == "" {
+		return ""
+	}
+
+	gutter := fmt.Sprintf("%d", r.Start.Line)
+	pad := strings.Repeat(" ", len(gutter))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", paint(color, ansiGray, gutter+" │"), line)
+
+	underlineWidth := r.End.Column - r.Start.Column
+	if underlineWidth < 1 {
+		underlineWidth = 1
+	}
+	underline := strings.Repeat(" ", max(r.Start.Column-1, 0)) + "^" + strings.Repeat("~", underlineWidth-1)
+	fmt.Fprintf(&b, "%s %s\n", paint(color, ansiGray, pad+" │"), paint(color, ansiRed, underline))
+
+	return b.String()
+}
+
+// sourceLine returns the 1-indexed n'th line of source, without its
+// trailing newline, or "" if source has fewer than n lines.
+func sourceLine(source []byte, n int) string {
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	for i := 1; scanner.Scan(); i++ {
+		if i == n {
+			return scanner.Text()
+		}
+	}
+	return ""
+}
+
+// wrapText breaks text into lines no longer than width, breaking on word
+// boundaries.
+func wrapText(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var lines []string
+	current := words[0]
+	for _, word := range words[1:] {
+		if len(current)+1+len(word) > width {
+			lines = append(lines, current)
+			current = word
+			continue
+		}
+		current += " " + word
+	}
+	lines = append(lines, current)
+
+	return lines
+}
+
+func paint(color bool, code, text string) string {
+	if !color {
+		return text
+	}
+	return code + text + ansiReset
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,154 @@
+// Package history persists a record of every generation run (its spec
+// snapshot, model, produced output, and dedup stats; Run.Seed has a field
+// but internal/cli's recordHistory leaves it zero) so a run can be
+// branched: re-run from a modified copy of a prior spec while keeping the
+// parent linkage, the way lmcli's conversation branching lets you fork a
+// chat from an earlier message instead of starting over.
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"gopkg.in/yaml.v3"
+
+	"github.com/copyleftdev/faux-foundry/internal/dedup"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// runsBucketName is the single Bolt bucket a Store keeps its recorded runs
+// in, keyed by Run.JobID.
+var runsBucketName = []byte("runs")
+
+// Run is a single recorded generation run, Store's unit of persistence.
+type Run struct {
+	JobID      string                   `json:"job_id"`
+	ParentID   string                   `json:"parent_id,omitempty"`
+	SpecHash   string                   `json:"spec_hash"`
+	Spec       types.Specification      `json:"spec"`
+	Seed       int64                    `json:"seed,omitempty"`
+	Model      string                   `json:"model"`
+	Provider   string                   `json:"provider,omitempty"`
+	OutputPath string                   `json:"output_path"`
+	Status     types.JobStatus          `json:"status"`
+	DedupStats dedup.DeduplicationStats `json:"dedup_stats"`
+	CreatedAt  time.Time                `json:"created_at"`
+}
+
+// HashSpec returns a content hash of spec, stable across re-marshaling
+// (yaml.Marshal of a types.Specification is deterministic field order, so
+// two loads of the same YAML always hash the same).
+func HashSpec(spec *types.Specification) (string, error) {
+	data, err := yaml.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Store is a Bolt-backed (go.etcd.io/bbolt) persistent log of Runs, the
+// same embedded-KV approach dedup.BoltStore already uses for its
+// seen-hashes set.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) a Store at path.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history store: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(runsBucketName)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create runs bucket: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Save persists run, overwriting any existing entry with the same JobID.
+func (s *Store) Save(run *Run) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to marshal run: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(runsBucketName).Put([]byte(run.JobID), data)
+	})
+}
+
+// Get returns the run recorded under jobID, or an error if none exists.
+func (s *Store) Get(jobID string) (*Run, error) {
+	var run *Run
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(runsBucketName).Get([]byte(jobID))
+		if data == nil {
+			return fmt.Errorf("no run recorded for job %q", jobID)
+		}
+		run = &Run{}
+		return json.Unmarshal(data, run)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// List returns every recorded run, oldest first.
+func (s *Store) List() ([]*Run, error) {
+	var runs []*Run
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(runsBucketName).ForEach(func(k, v []byte) error {
+			run := &Run{}
+			if err := json.Unmarshal(v, run); err != nil {
+				return fmt.Errorf("failed to unmarshal run %q: %w", string(k), err)
+			}
+			runs = append(runs, run)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs: %w", err)
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.Before(runs[j].CreatedAt)
+	})
+	return runs, nil
+}
+
+// Children returns every run whose ParentID is parentJobID, oldest first,
+// i.e. the branches made off of that run.
+func (s *Store) Children(parentJobID string) ([]*Run, error) {
+	all, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var children []*Run
+	for _, run := range all {
+		if run.ParentID == parentJobID {
+			children = append(children, run)
+		}
+	}
+	return children, nil
+}
+
+// Close releases the underlying Bolt file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
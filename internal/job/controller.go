@@ -0,0 +1,312 @@
+// Package job implements the generation engine shared by the plain CLI
+// path (`fauxfoundry generate`) and the interactive TUI monitor
+// (`fauxfoundry generate --interactive` / `fauxfoundry tui`): the batch
+// generate/dedupe/write loop that used to live entirely inside
+// internal/cli's runGeneration now lives here as Controller, so neither
+// caller has to duplicate it - they only differ in how they drain
+// Controller.Updates/Records and render them.
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/agents"
+	"github.com/copyleftdev/faux-foundry/internal/dedup"
+	"github.com/copyleftdev/faux-foundry/internal/events"
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/output"
+	"github.com/copyleftdev/faux-foundry/internal/worker"
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Phase identifies where in a batch a Snapshot was taken, so a consumer
+// that only cares about batch-level status (the CLI's plain progress log)
+// can ignore the per-record ones, while a consumer driving a live bar
+// (the TUI monitor) can use every one of them.
+type Phase string
+
+const (
+	// PhaseBatchStarted fires once a batch's records have come back from
+	// the model and are about to be deduplicated/tooled/written - not when
+	// the request for them went out, since with Workers > 1 several
+	// batches can be in flight at once and only the single writer
+	// goroutine (where this fires) has a well-defined "next" batch.
+	PhaseBatchStarted   Phase = "batch_started"
+	PhaseRecordWritten  Phase = "record_written"
+	PhaseBatchCompleted Phase = "batch_completed"
+)
+
+// Snapshot is what Controller.Run sends on Updates: the job's Progress at
+// that moment, which Phase/batch it was taken in, and the current dedup
+// stats (cheap to recompute, but bundling it saves every consumer its own
+// racing call against Run's goroutine).
+type Snapshot struct {
+	Phase       Phase
+	Progress    types.Progress
+	BatchNumber int
+	Dedup       dedup.DeduplicationStats
+
+	// WorkerID and BatchSize attribute this Snapshot back to the
+	// worker.Pool goroutine that generated BatchNumber and the size it
+	// requested, letting a consumer (e.g. the TUI's GenerationRunner)
+	// render a progress bar per worker instead of only the aggregate job
+	// Progress every Snapshot already carries.
+	WorkerID  int
+	BatchSize int
+}
+
+// Controller drives a single GenerationJob to completion: it generates
+// batches through LLM, deduplicates and runs field tools on them, writes
+// them through Writer, and publishes lifecycle events through Events,
+// while reporting progress over Updates/Records for a caller to render.
+type Controller struct {
+	LLM    llm.Client
+	Writer *output.StreamingWriter
+	Dedup  *dedup.BatchDeduplicator
+	Agents *agents.Executor
+	Events *events.Bus
+	Logger log.Logger
+	Job    *types.GenerationJob
+
+	// Workers is how many batches Run requests concurrently from LLM. < 1
+	// behaves like 1 (sequential) - the same behavior Run had before
+	// internal/worker existed.
+	Workers int
+
+	retryConfig atomic.Pointer[llm.RetryConfig]
+	paused      atomic.Bool
+
+	// Updates receives a Snapshot at every batch start, every record
+	// write, and every batch completion. Records receives each record as
+	// it's written, for a monitor's preview pane. Both are closed when Run
+	// returns, so a consumer's `for range` over either ends exactly when
+	// Run does.
+	Updates chan Snapshot
+	Records chan types.Record
+}
+
+// NewController builds a Controller for j, ready for Run to drive. workers
+// sets how many batches Run requests concurrently (see Controller.Workers).
+func NewController(client llm.Client, writer *output.StreamingWriter, deduplicator *dedup.BatchDeduplicator, executor *agents.Executor, bus *events.Bus, logger log.Logger, j *types.GenerationJob, retryConfig *llm.RetryConfig, workers int) *Controller {
+	c := &Controller{
+		LLM:     client,
+		Writer:  writer,
+		Dedup:   deduplicator,
+		Agents:  executor,
+		Events:  bus,
+		Logger:  logger,
+		Job:     j,
+		Workers: workers,
+		Updates: make(chan Snapshot, 16),
+		Records: make(chan types.Record, 16),
+	}
+	c.retryConfig.Store(retryConfig)
+	return c
+}
+
+// SetRetryConfig swaps in a new retry config, picked up at the start of
+// the next batch Run runs - this is how a live monitor adjusts MaxRetries
+// or MinBatchSize without restarting the job.
+func (c *Controller) SetRetryConfig(cfg *llm.RetryConfig) {
+	c.retryConfig.Store(cfg)
+}
+
+// RetryConfig returns the retry config currently in effect.
+func (c *Controller) RetryConfig() *llm.RetryConfig {
+	return c.retryConfig.Load()
+}
+
+// Pause holds the batch loop between batches without cancelling the job;
+// Resume lets it continue. Both are safe to call from a goroutine other
+// than Run's, e.g. a Bubble Tea Update handler reacting to a keypress.
+func (c *Controller) Pause()  { c.paused.Store(true) }
+func (c *Controller) Resume() { c.paused.Store(false) }
+
+// TogglePause flips the paused state and returns the value after flipping.
+func (c *Controller) TogglePause() bool {
+	if c.paused.Load() {
+		c.Resume()
+		return false
+	}
+	c.Pause()
+	return true
+}
+
+// Paused reports whether the batch loop is currently holding.
+func (c *Controller) Paused() bool { return c.paused.Load() }
+
+// Run executes Job's batch/dedupe/write loop until Job.Progress.Target
+// records have been written, ctx is cancelled, or a batch fails. It
+// closes Updates and Records before returning.
+func (c *Controller) Run(ctx context.Context) (err error) {
+	defer close(c.Updates)
+	defer close(c.Records)
+	defer func() {
+		if err != nil {
+			c.Events.Publish(ctx, events.JobFailed, types.JobStatusFailed, c.Job.Progress, err.Error())
+		} else {
+			c.Events.Publish(ctx, events.JobCompleted, c.Job.Status, c.Job.Progress, "")
+		}
+	}()
+
+	job := c.Job
+	job.Status = types.JobStatusRunning
+	startTime := time.Now()
+	generated := 0
+
+	c.Events.Publish(ctx, events.JobStarted, types.JobStatusRunning, job.Progress, "")
+
+	// runCtx is cancelled as soon as Run decides to return, for any reason
+	// (a batch failed, the writer failed, ctx itself was cancelled) - that
+	// unblocks any worker still waiting on a send or mid-generate, so Run
+	// never leaves one running past its own return. c.Dedup/c.Writer are
+	// only ever touched from this goroutine (the Reorder consumer below),
+	// never from a worker, so neither needs to be concurrency-safe.
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+
+	pool := &worker.Pool{
+		Workers: c.Workers,
+		Generate: func(genCtx context.Context, count int) ([]types.Record, error) {
+			if waitErr := c.waitWhilePaused(genCtx); waitErr != nil {
+				return nil, waitErr
+			}
+			return c.LLM.GenerateWithConfig(genCtx, &job.Spec, count, c.retryConfig.Load())
+		},
+	}
+	it := worker.NewIterator(job.Progress.Target, job.Spec.Model.BatchSize)
+	results := worker.Reorder(pool.Run(runCtx, it))
+
+	var firstErr error
+	for result := range results {
+		if firstErr != nil {
+			continue // draining: let every in-flight worker finish/unblock
+		}
+		if result.Err != nil {
+			firstErr = fmt.Errorf("failed to generate batch %d: %w", result.Job.BatchNumber, result.Err)
+			cancelRun()
+			continue
+		}
+
+		batchCount := result.Job.BatchNumber
+		workerID := result.WorkerID
+		batchSize := result.Job.Size
+		job.Progress.BatchSize = batchSize
+
+		c.Writer.SetBatchAttrs(map[string]string{
+			"batch-id":  fmt.Sprintf("%d", batchCount),
+			"timestamp": time.Now().UTC().Format(time.RFC3339),
+		})
+
+		c.send(Snapshot{Phase: PhaseBatchStarted, Progress: job.Progress, BatchNumber: batchCount, Dedup: c.Dedup.GetStats(), WorkerID: workerID, BatchSize: batchSize})
+
+		// Field tool overrides run before dedup, not after: they replace
+		// record[field.Name] outright, so hashing the model's raw output
+		// first would compute uniqueness on values the overrides are about
+		// to discard - two records differing only in a field a tool
+		// overrides (e.g. a sql_query-derived one) could dedup-collide or
+		// miss colliding based on content that never reaches the output.
+		if toolErr := c.Agents.ApplyToRecords(ctx, job.Spec.Dataset.Fields, result.Records); toolErr != nil {
+			firstErr = fmt.Errorf("failed to apply field tool overrides to batch %d: %w", batchCount, toolErr)
+			cancelRun()
+			continue
+		}
+
+		uniqueRecords := c.Dedup.ProcessBatch(result.Records)
+		if dropped := len(result.Records) - len(uniqueRecords); dropped > 0 {
+			c.Events.Publish(ctx, events.DedupDuplicate, job.Status, job.Progress, fmt.Sprintf("%d duplicate(s) dropped from batch %d", dropped, batchCount))
+		}
+
+		writeFailed := false
+		for _, record := range uniqueRecords {
+			if writeErr := c.Writer.Write(record); writeErr != nil {
+				firstErr = fmt.Errorf("failed to write record: %w", writeErr)
+				cancelRun()
+				writeFailed = true
+				break
+			}
+			generated++
+
+			job.Progress.Generated = generated
+			elapsed := time.Since(startTime)
+			job.Progress.ElapsedTime = elapsed.String()
+			if generated > 0 {
+				rate := float64(generated) / elapsed.Seconds()
+				job.Progress.Rate = rate
+				if rate > 0 {
+					remainingRecords := job.Progress.Target - generated
+					eta := time.Duration(float64(remainingRecords)/rate) * time.Second
+					job.Progress.EstimatedETA = eta.String()
+				}
+			}
+
+			c.Events.Publish(ctx, events.RecordWritten, job.Status, job.Progress, "")
+			c.Records <- record
+			c.send(Snapshot{Phase: PhaseRecordWritten, Progress: job.Progress, BatchNumber: batchCount, Dedup: c.Dedup.GetStats(), WorkerID: workerID, BatchSize: batchSize})
+		}
+		if writeFailed {
+			continue
+		}
+
+		c.Events.Publish(ctx, events.BatchCompleted, job.Status, job.Progress, fmt.Sprintf("batch %d", batchCount))
+		c.send(Snapshot{Phase: PhaseBatchCompleted, Progress: job.Progress, BatchNumber: batchCount, Dedup: c.Dedup.GetStats(), WorkerID: workerID, BatchSize: batchSize})
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("generation timed out: %w", ctxErr)
+	}
+
+	job.Status = types.JobStatusCompleted
+	endTime := time.Now()
+	job.EndTime = &endTime
+
+	if err := c.Writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush output: %w", err)
+	}
+
+	return nil
+}
+
+// waitWhilePaused blocks while c is paused, returning early with ctx's
+// error if it's cancelled first. Called from each worker goroutine before
+// it generates a batch, so a pause holds every in-flight worker at the
+// next batch boundary instead of only the single sequential loop.
+func (c *Controller) waitWhilePaused(ctx context.Context) error {
+	for c.paused.Load() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+	return nil
+}
+
+// send delivers snap on Updates, dropping it instead of blocking Run if no
+// one is reading fast enough - a stalled monitor should never throttle
+// generation (Updates' buffer already absorbs the common case; this is
+// only the backstop for a monitor that's fallen far behind or exited).
+func (c *Controller) send(snap Snapshot) {
+	select {
+	case c.Updates <- snap:
+	default:
+	}
+}
+
+// Close releases Writer and Events. Call it once Run has returned.
+func (c *Controller) Close() error {
+	writerErr := c.Writer.Close()
+	eventsErr := c.Events.Close()
+	if writerErr != nil {
+		return writerErr
+	}
+	return eventsErr
+}
@@ -0,0 +1,130 @@
+package llm
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// BackoffStop is the sentinel Backoff.NextBackoff returns to signal that
+// retrying should stop — typically because a schedule's MaxElapsedTime
+// budget has been exhausted.
+const BackoffStop time.Duration = -1
+
+// Backoff computes the wait between successive retry attempts. It's the
+// extension point TimeoutHandler.Backoff exposes so callers can plug in a
+// constant delay, exponential backoff, or a custom schedule (e.g. one that
+// never sleeps, for fast tests) instead of being stuck with one hard-coded
+// policy.
+type Backoff interface {
+	// NextBackoff returns how long to wait before the next attempt, or
+	// BackoffStop if the schedule says to give up instead.
+	NextBackoff() time.Duration
+	// Reset restarts the schedule from its initial state. Callers should
+	// call it once per logical retry loop (e.g. at the start of
+	// GenerateWithRetry), not once per process.
+	Reset()
+}
+
+// ConstantBackoff always waits the same Interval between attempts.
+type ConstantBackoff struct {
+	Interval time.Duration
+}
+
+// NextBackoff always returns c.Interval.
+func (c *ConstantBackoff) NextBackoff() time.Duration { return c.Interval }
+
+// Reset is a no-op: ConstantBackoff has no state to restart.
+func (c *ConstantBackoff) Reset() {}
+
+// ExponentialBackoff implements the retry semantics popularized by
+// cenkalti/backoff: each interval grows by Multiplier up to MaxInterval,
+// jittered by RandomizationFactor so concurrent callers retrying the same
+// failure don't all wake up in lockstep, until MaxElapsedTime (measured
+// from the last Reset) runs out.
+type ExponentialBackoff struct {
+	InitialInterval     time.Duration
+	MaxInterval         time.Duration
+	Multiplier          float64
+	RandomizationFactor float64
+	MaxElapsedTime      time.Duration
+
+	currentInterval time.Duration
+	startTime       time.Time
+}
+
+// Reset restarts the schedule: the next NextBackoff call returns
+// InitialInterval (jittered), and MaxElapsedTime is measured from now.
+func (b *ExponentialBackoff) Reset() {
+	b.currentInterval = b.InitialInterval
+	b.startTime = time.Now()
+}
+
+// NextBackoff returns the jittered current interval and grows it by
+// Multiplier (capped at MaxInterval) for next time, or BackoffStop once
+// MaxElapsedTime has elapsed since the last Reset.
+func (b *ExponentialBackoff) NextBackoff() time.Duration {
+	if b.startTime.IsZero() {
+		b.Reset()
+	}
+
+	if b.MaxElapsedTime > 0 && time.Since(b.startTime) > b.MaxElapsedTime {
+		return BackoffStop
+	}
+
+	interval := randomize(b.currentInterval, b.RandomizationFactor)
+
+	next := time.Duration(float64(b.currentInterval) * b.Multiplier)
+	if b.MaxInterval > 0 && next > b.MaxInterval {
+		next = b.MaxInterval
+	}
+	b.currentInterval = next
+
+	return interval
+}
+
+// randomize draws uniformly from [interval*(1-factor), interval*(1+factor)].
+// factor <= 0 disables jitter and returns interval unchanged.
+func randomize(interval time.Duration, factor float64) time.Duration {
+	if factor <= 0 || interval <= 0 {
+		return interval
+	}
+
+	delta := factor * float64(interval)
+	lo := float64(interval) - delta
+	hi := float64(interval) + delta
+
+	return time.Duration(lo + rand.Float64()*(hi-lo))
+}
+
+// PermanentError wraps an error to mark it as non-retryable, the way
+// cenkalti/backoff's Permanent does: determineStrategy returns
+// StrategyAbort for it instead of retrying, reducing, simplifying, or
+// falling back. Use it for errors no amount of retrying can fix — an HTTP
+// 4xx from Ollama, a malformed spec.
+type PermanentError struct {
+	Err error
+}
+
+// Permanent wraps err so IsPermanent reports true for it. Returns nil
+// unchanged, so it's safe to wrap the result of a call that might not
+// error.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+// Error returns the wrapped error's message.
+func (e *PermanentError) Error() string { return e.Err.Error() }
+
+// Unwrap exposes the wrapped error to errors.Is/errors.As.
+func (e *PermanentError) Unwrap() error { return e.Err }
+
+// IsPermanent reports whether err (or anything it wraps) is a
+// *PermanentError.
+func IsPermanent(err error) bool {
+	var perm *PermanentError
+	return errors.As(err, &perm)
+}
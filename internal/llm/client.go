@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
-	"strings"
+	"os"
 	"time"
 
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/metrics"
 	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
@@ -18,20 +21,72 @@ type Client interface {
 	Generate(ctx context.Context, spec *types.Specification, count int) ([]types.Record, error)
 	TestConnection(ctx context.Context, endpoint string) error
 	ListModels(ctx context.Context, endpoint string) ([]string, error)
+	CheckOllamaHealth(ctx context.Context, endpoint string) (*OllamaHealth, error)
+
+	// CheckModelHealth is CheckOllamaHealth's provider-aware counterpart:
+	// for spec.Model.Provider left empty (or "ollama") it's identical to
+	// CheckOllamaHealth(ctx, spec.Model.Endpoint); for any other provider
+	// it reports reachability and available models from that provider's
+	// Health/ListModels instead, reusing the same OllamaHealth shape so
+	// callers don't need a provider-specific branch.
+	CheckModelHealth(ctx context.Context, spec *types.Specification) (*OllamaHealth, error)
+
+	GenerateWithConfig(ctx context.Context, spec *types.Specification, count int, config *RetryConfig) ([]types.Record, error)
+
+	// GenerateStream generates like Generate, but delivers each record as
+	// soon as its JSON object closes in the model's streamed response
+	// instead of waiting for the whole completion to buffer. The error
+	// channel carries at most one value and is closed alongside records.
+	GenerateStream(ctx context.Context, spec *types.Specification, count int) (<-chan types.Record, <-chan error)
 }
 
 // OllamaClient implements the Client interface for Ollama
 type OllamaClient struct {
 	httpClient *http.Client
+
+	// Metrics, if set, is copied onto every TimeoutHandler this client
+	// builds so generation records Prometheus metrics and OpenTelemetry
+	// spans. Nil (the default from NewOllamaClient) disables
+	// instrumentation entirely.
+	Metrics *metrics.Registry
+
+	// Logger receives every structured event GenerateBasic and the
+	// TimeoutHandlers built from this client emit. Unlike Metrics it's
+	// never nil: NewOllamaClient seeds it with a plain stderr logger so a
+	// caller that never touches this field still gets log output, and
+	// NewApp overwrites it with the CLI's configured logger.
+	Logger log.Logger
+
+	// deadlineTimer backs SetGenerateDeadline/SetParseDeadline: independent,
+	// resettable deadlines for GenerateBasic's HTTP round-trip and
+	// response-parsing phase.
+	deadlineTimer
 }
 
 // NewOllamaClient creates a new Ollama client
 func NewOllamaClient() *OllamaClient {
-	return &OllamaClient{
+	c := &OllamaClient{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		Logger: log.New(log.FormatConsole, slog.LevelInfo, os.Stderr),
 	}
+	c.deadlineTimer.init()
+	return c
+}
+
+// SetGenerateDeadline sets the deadline for the HTTP round-trip to Ollama
+// that GenerateBasic performs. A zero Time clears it. Resetting it while a
+// call is in flight takes effect immediately, the same way net.Conn's
+// SetDeadline does.
+func (c *OllamaClient) SetGenerateDeadline(t time.Time) {
+	c.setReadDeadline(t)
+}
+
+// SetParseDeadline sets the deadline for parsing Ollama's response in
+// GenerateBasic. A zero Time clears it.
+func (c *OllamaClient) SetParseDeadline(t time.Time) {
+	c.setWriteDeadline(t)
 }
 
 // OllamaRequest represents a request to Ollama API
@@ -40,14 +95,33 @@ type OllamaRequest struct {
 	Prompt string `json:"prompt"`
 	Stream bool   `json:"stream"`
 	Options map[string]interface{} `json:"options,omitempty"`
+
+	// Format activates Ollama's structured-output mode: either the literal
+	// string "json", or (as set from a spec's Dataset.Schema by
+	// schemaFormat) a JSON Schema object the model's output must validate
+	// against.
+	Format interface{} `json:"format,omitempty"`
+}
+
+// schemaFormat returns schema as the value OllamaRequest.Format expects, or
+// nil if schema is empty — Ollama's structured-output constraint is opt-in
+// per request, so a dataset without a Schema gets none.
+func schemaFormat(schema map[string]interface{}) interface{} {
+	if len(schema) == 0 {
+		return nil
+	}
+	return schema
 }
 
 // OllamaResponse represents a response from Ollama API
 type OllamaResponse struct {
-	Model     string `json:"model"`
-	Response  string `json:"response"`
-	Done      bool   `json:"done"`
-	Error     string `json:"error,omitempty"`
+	Model           string `json:"model"`
+	Response        string `json:"response"`
+	Done            bool   `json:"done"`
+	Error           string `json:"error,omitempty"`
+	PromptEvalCount int    `json:"prompt_eval_count,omitempty"`
+	EvalCount       int    `json:"eval_count,omitempty"`
+	EvalDuration    int64  `json:"eval_duration,omitempty"`
 }
 
 // Generate generates synthetic data using the LLM with timeout handling
@@ -64,11 +138,35 @@ func (c *OllamaClient) GenerateWithConfig(ctx context.Context, spec *types.Speci
 	return handler.GenerateWithRetry(ctx, spec, count)
 }
 
-// GenerateBasic generates data without timeout handling (for internal use)
+// CompletePrompt sends a single non-streaming prompt to Ollama's
+// /api/generate endpoint and returns the raw completion text. It is the
+// low-level primitive the ollama provider.Provider adapter builds on.
+func (c *OllamaClient) CompletePrompt(ctx context.Context, endpoint, model, prompt string, temperature float64) (string, error) {
+	req := OllamaRequest{
+		Model:  model,
+		Prompt: prompt,
+		Stream: false,
+		Options: map[string]interface{}{
+			"temperature": temperature,
+		},
+	}
+
+	resp, err := c.makeRequest(ctx, endpoint+"/api/generate", req)
+	if err != nil {
+		return "", fmt.Errorf("failed to complete prompt: %w", err)
+	}
+
+	return resp.Response, nil
+}
+
+// GenerateBasic generates data without timeout handling (for internal use).
+// The HTTP round-trip and the response-parsing phase each run under their
+// own deadline (SetGenerateDeadline/SetParseDeadline), so one can be reset
+// without tearing down the other.
 func (c *OllamaClient) GenerateBasic(ctx context.Context, spec *types.Specification, count int) ([]types.Record, error) {
 	// Build prompt from specification
-	prompt := c.buildPrompt(spec, count)
-	
+	prompt := buildPrompt(spec, count)
+
 	// Make request to Ollama
 	req := OllamaRequest{
 		Model:  spec.Model.Name,
@@ -77,25 +175,55 @@ func (c *OllamaClient) GenerateBasic(ctx context.Context, spec *types.Specificat
 		Options: map[string]interface{}{
 			"temperature": spec.Model.Temperature,
 		},
+		Format: schemaFormat(spec.Dataset.Schema),
 	}
-	
-	response, err := c.makeRequest(ctx, spec.Model.Endpoint+"/api/generate", req)
+
+	genCtx, cancel := withCancelChan(ctx, c.readChan())
+	defer cancel()
+
+	response, err := c.makeRequest(genCtx, spec.Model.Endpoint+"/api/generate", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate data: %w", err)
 	}
-	
-	// Debug: Show that we got a real LLM response
-	fmt.Printf("🤖 Raw LLM Response (%d chars): %s...\n", len(response.Response), response.Response[:min(100, len(response.Response))])
-	
-	// Parse response into records
-	records, err := c.parseResponse(response.Response, spec)
+
+	c.Logger.Debug("received raw LLM response",
+		"chars", len(response.Response),
+		"preview", response.Response[:min(100, len(response.Response))])
+
+	records, err := c.parseResponseWithDeadline(ctx, response.Response, spec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
-	
+
 	return records, nil
 }
 
+// parseResponseWithDeadline runs parseResponse in a goroutine and returns
+// as soon as it finishes, ctx is cancelled, or the parse deadline set by
+// SetParseDeadline fires — whichever comes first. parseResponse itself has
+// no cancellation point, so this is the only way to bound its run time.
+func (c *OllamaClient) parseResponseWithDeadline(ctx context.Context, response string, spec *types.Specification) ([]types.Record, error) {
+	type result struct {
+		records []types.Record
+		err     error
+	}
+	resultCh := make(chan result, 1)
+
+	go func() {
+		records, err := parseGeneratedRecords(c.Logger, response, spec)
+		resultCh <- result{records, err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		return res.records, res.err
+	case <-c.writeChan():
+		return nil, fmt.Errorf("parse deadline exceeded")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // generateDemoData generates realistic demo data without requiring Ollama
 func (c *OllamaClient) generateDemoData(spec *types.Specification, count int) []types.Record {
 	records := make([]types.Record, 0, count)
@@ -111,7 +239,7 @@ func (c *OllamaClient) generateDemoData(spec *types.Specification, count int) []
 			switch field.Type {
 			case "string":
 				if field.Pattern != "" {
-					record[field.Name] = c.generatePatternString(field.Pattern, i)
+					record[field.Name] = generatePatternString(field.Pattern, i)
 				} else if field.Name == "first_name" {
 					record[field.Name] = firstNames[i%len(firstNames)]
 				} else if field.Name == "last_name" {
@@ -162,48 +290,6 @@ func (c *OllamaClient) generateDemoData(spec *types.Specification, count int) []
 	return records
 }
 
-// generatePatternString generates a string that matches a regex pattern
-func (c *OllamaClient) generatePatternString(pattern string, seed int) string {
-	// Simple pattern matching for common medical patterns
-	switch pattern {
-	case "^PAT[0-9]{8}$":
-		return fmt.Sprintf("PAT%08d", 10000000+seed)
-	case "^[A-Z]{3}[0-9]{9}$":
-		return fmt.Sprintf("ABC%09d", 100000000+seed)
-	case "^[0-9]{3}-[0-9]{2}-[0-9]{4}$":
-		return fmt.Sprintf("%03d-%02d-%04d", 100+seed%900, seed%100, 1000+seed%9000)
-	case "^[0-9]{5}(-[0-9]{4})?$":
-		if seed%2 == 0 {
-			return fmt.Sprintf("%05d", 10000+seed%90000)
-		}
-		return fmt.Sprintf("%05d-%04d", 10000+seed%90000, 1000+seed%9000)
-	case "^\\([0-9]{3}\\) [0-9]{3}-[0-9]{4}$":
-		return fmt.Sprintf("(%03d) %03d-%04d", 200+seed%800, 100+seed%900, 1000+seed%9000)
-	case "^GRP[0-9]{6}[A-Z]{2}$":
-		letters := "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-		return fmt.Sprintf("GRP%06d%c%c", 100000+seed%900000, 
-			letters[seed%26], letters[(seed+1)%26])
-	case "^POL[0-9]{10}$":
-		return fmt.Sprintf("POL%010d", 1000000000+seed%9000000000)
-	case "^EDI[0-9]{12}$":
-		return fmt.Sprintf("EDI%012d", seed)
-	case "^[0-9]{5}$":
-		return fmt.Sprintf("%05d", 10000+seed%90000)
-	case "^[0-9]{10}$":
-		return fmt.Sprintf("%010d", 1000000000+seed%9000000000)
-	case "^[0-9]{9}$":
-		return fmt.Sprintf("%09d", 100000000+seed%900000000)
-	case "^[0-9]{2}-[0-9]{7}$":
-		return fmt.Sprintf("%02d-%07d", 10+seed%90, 1000000+seed%9000000)
-	case "^CH[0-9]{6}$":
-		return fmt.Sprintf("CH%06d", 100000+seed%900000)
-	case "^BTH[0-9]{8}$":
-		return fmt.Sprintf("BTH%08d", 10000000+seed%90000000)
-	default:
-		return fmt.Sprintf("pattern_match_%d", seed)
-	}
-}
-
 // TestConnection tests the connection to an Ollama endpoint
 func (c *OllamaClient) TestConnection(ctx context.Context, endpoint string) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"/api/tags", nil)
@@ -259,46 +345,6 @@ func (c *OllamaClient) ListModels(ctx context.Context, endpoint string) ([]strin
 	return models, nil
 }
 
-// buildPrompt builds a prompt from the specification
-func (c *OllamaClient) buildPrompt(spec *types.Specification, count int) string {
-	prompt := fmt.Sprintf(`Generate %d unique JSON records for %s.
-
-Each record should be a valid JSON object with the following fields:
-`, count, spec.Dataset.Domain)
-
-	for _, field := range spec.Dataset.Fields {
-		prompt += fmt.Sprintf("- %s (%s)", field.Name, field.Type)
-		if field.Required {
-			prompt += " [required]"
-		}
-		if field.Description != "" {
-			prompt += fmt.Sprintf(": %s", field.Description)
-		}
-		if field.Pattern != "" {
-			prompt += fmt.Sprintf(" (pattern: %s)", field.Pattern)
-		}
-		if len(field.Range) == 2 {
-			prompt += fmt.Sprintf(" (range: %d-%d)", field.Range[0], field.Range[1])
-		}
-		if len(field.Values) > 0 {
-			prompt += fmt.Sprintf(" (values: %v)", field.Values)
-		}
-		prompt += "\n"
-	}
-
-	prompt += `
-Requirements:
-- Each record must be unique
-- Output only valid JSON objects, one per line
-- Follow the field constraints exactly
-- Make the data realistic and diverse
-- Do not include any explanatory text
-
-Generate the records now:`
-
-	return prompt
-}
-
 // makeRequest makes an HTTP request to Ollama
 func (c *OllamaClient) makeRequest(ctx context.Context, url string, req OllamaRequest) (*OllamaResponse, error) {
 	jsonData, err := json.Marshal(req)
@@ -336,97 +382,6 @@ func (c *OllamaClient) makeRequest(ctx context.Context, url string, req OllamaRe
 	return &response, nil
 }
 
-// parseResponse parses the LLM response into records
-func (c *OllamaClient) parseResponse(response string, spec *types.Specification) ([]types.Record, error) {
-	records := make([]types.Record, 0)
-	
-	// Remove markdown formatting
-	response = strings.ReplaceAll(response, "```json", "")
-	response = strings.ReplaceAll(response, "```", "")
-	
-	// Split into potential JSON objects by looking for }{ patterns and newlines
-	response = strings.ReplaceAll(response, "}\n{", "}\n\n{")
-	response = strings.ReplaceAll(response, "}{", "}\n\n{")
-	
-	// Split by double newlines to separate JSON objects
-	parts := strings.Split(response, "\n\n")
-	
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		
-		// Skip empty parts or explanatory text
-		if part == "" || 
-		   strings.HasPrefix(part, "Here") || strings.HasPrefix(part, "I'll") ||
-		   strings.HasPrefix(part, "The") || strings.HasPrefix(part, "Based") ||
-		   strings.HasPrefix(part, "Note") || strings.HasPrefix(part, "This") {
-			continue
-		}
-		
-		// Try to parse as JSON object
-		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
-			var record types.Record
-			if err := json.Unmarshal([]byte(part), &record); err != nil {
-				// Try to fix common JSON issues
-				fixedPart := c.fixCommonJSONIssues(part)
-				if err := json.Unmarshal([]byte(fixedPart), &record); err != nil {
-					continue // Skip this record if we can't parse it
-				}
-			}
-			
-			// Validate that the record has the expected fields
-			if c.validateRecord(record, spec) {
-				records = append(records, record)
-			}
-		}
-	}
-	
-	// If we couldn't parse any records from the LLM response, return an error
-	if len(records) == 0 {
-		fmt.Printf("❌ Failed to parse any records from LLM response\n")
-		fmt.Printf("LLM Response (first 500 chars): %s\n", response[:min(500, len(response))])
-		fmt.Printf("Response length: %d characters\n", len(response))
-		return nil, fmt.Errorf("could not parse any valid JSON records from LLM response")
-	}
-	
-	fmt.Printf("✅ Successfully parsed %d records from LLM response\n", len(records))
-	return records, nil
-}
-
-// fixCommonJSONIssues attempts to fix common JSON formatting issues
-func (c *OllamaClient) fixCommonJSONIssues(jsonStr string) string {
-	// Remove trailing commas before closing braces
-	jsonStr = strings.ReplaceAll(jsonStr, ",\n}", "\n}")
-	jsonStr = strings.ReplaceAll(jsonStr, ", }", " }")
-	
-	// Fix null values that might be unquoted
-	jsonStr = strings.ReplaceAll(jsonStr, ": null", ": null")
-	
-	return jsonStr
-}
-
-// validateRecord checks if a record contains the required fields from the spec
-func (c *OllamaClient) validateRecord(record types.Record, spec *types.Specification) bool {
-	requiredFields := 0
-	presentFields := 0
-	
-	for _, field := range spec.Dataset.Fields {
-		if field.Required {
-			requiredFields++
-			if _, exists := record[field.Name]; exists {
-				presentFields++
-			}
-		}
-	}
-	
-	// Require at least 80% of required fields to be present
-	if requiredFields > 0 {
-		return float64(presentFields)/float64(requiredFields) >= 0.8
-	}
-	
-	// If no required fields, just check that we have some fields
-	return len(record) > 0
-}
-
 // IsVerbose checks if verbose mode is enabled (placeholder - would be injected)
 func IsVerbose() bool {
 	// TODO: This should be injected from CLI context
@@ -0,0 +1,108 @@
+package llm
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// deadlineTimer implements a pair of resettable, independently-cancellable
+// deadlines, modeled on the pattern netstack-style connections use for
+// read/write deadlines: each deadline is backed by a channel that closes
+// when its *time.Timer fires, so a caller selects on the channel instead of
+// polling time.Now(). OllamaClient embeds one to give GenerateBasic's HTTP
+// round-trip and response-parsing phase independent deadlines that
+// SetGenerateDeadline/SetParseDeadline can reset mid-retry without tearing
+// down the other.
+//
+// The field names (readTimer/writeTimer) mirror that upstream
+// read/write-deadline terminology even though neither phase here is
+// actually a socket read or write.
+type deadlineTimer struct {
+	mu sync.Mutex
+
+	readTimer    *time.Timer
+	readCancelCh chan struct{}
+
+	writeTimer    *time.Timer
+	writeCancelCh chan struct{}
+}
+
+// init must be called once, before any other deadlineTimer method, to open
+// both cancel channels.
+func (d *deadlineTimer) init() {
+	d.readCancelCh = make(chan struct{})
+	d.writeCancelCh = make(chan struct{})
+}
+
+// readChan returns the channel that closes when the generate deadline set
+// by setReadDeadline expires. It's safe to call — and select on — again
+// and again across retries; setReadDeadline swaps in a fresh channel each
+// time the previous one has already fired.
+func (d *deadlineTimer) readChan() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readCancelCh
+}
+
+// writeChan returns the channel that closes when the parse deadline set by
+// setWriteDeadline expires.
+func (d *deadlineTimer) writeChan() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeCancelCh
+}
+
+// setReadDeadline arms the generate deadline for t. A zero t clears it
+// without leaking a timer.
+func (d *deadlineTimer) setReadDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.readTimer, d.readCancelCh = setDeadline(d.readTimer, d.readCancelCh, t)
+}
+
+// setWriteDeadline arms the parse deadline for t. A zero t clears it
+// without leaking a timer.
+func (d *deadlineTimer) setWriteDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.writeTimer, d.writeCancelCh = setDeadline(d.writeTimer, d.writeCancelCh, t)
+}
+
+// setDeadline stops timer (if any), replaces cancelCh with a fresh, open
+// channel if the old timer had already fired, and — unless t is the zero
+// Time, which only clears the deadline — schedules a new time.AfterFunc
+// that closes cancelCh when t arrives. Callers must hold the owning
+// deadlineTimer's mutex.
+func setDeadline(timer *time.Timer, cancelCh chan struct{}, t time.Time) (*time.Timer, chan struct{}) {
+	if timer != nil && !timer.Stop() {
+		// The timer already fired, which means cancelCh is already
+		// closed: swap in a fresh one before arming the next deadline.
+		cancelCh = make(chan struct{})
+	}
+
+	if t.IsZero() {
+		return nil, cancelCh
+	}
+
+	ch := cancelCh
+	timer = time.AfterFunc(time.Until(t), func() { close(ch) })
+	return timer, cancelCh
+}
+
+// withCancelChan derives a context from parent that's also cancelled when
+// done closes, so a deadlineTimer channel can short-circuit a ctx-aware
+// call like http.NewRequestWithContext without that call knowing anything
+// about deadlineTimer. The returned cancel func must be called once the
+// derived context is no longer needed, to stop the internal goroutine.
+func withCancelChan(parent context.Context, done <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
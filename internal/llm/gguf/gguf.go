@@ -0,0 +1,279 @@
+// Package gguf parses the GGUF model format that Ollama caches blobs in
+// under ~/.ollama/models/blobs, so FauxFoundry can learn a model's true
+// context length and memory footprint without relying on Ollama's /api/tags
+// response, which exposes neither.
+package gguf
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const magic = "GGUF"
+
+// valueType is a GGUF metadata value's wire type.
+type valueType uint32
+
+const (
+	typeUint8 valueType = iota
+	typeInt8
+	typeUint16
+	typeInt16
+	typeUint32
+	typeInt32
+	typeFloat32
+	typeBool
+	typeString
+	typeArray
+	typeUint64
+	typeInt64
+	typeFloat64
+)
+
+// TensorInfo describes one tensor stored in a GGUF file.
+type TensorInfo struct {
+	Name   string
+	Type   uint32
+	Shape  []uint64
+	Offset uint64
+}
+
+// Model is the subset of a GGUF file's header FauxFoundry cares about:
+// enough metadata to estimate VRAM usage without loading tensor data.
+type Model struct {
+	Architecture    string
+	ContextLength   uint64
+	EmbeddingLength uint64
+	BlockCount      uint64
+	Metadata        map[string]interface{}
+	Tensors         []TensorInfo
+}
+
+// ParseFile reads a GGUF model's header (magic, version, KV metadata, and
+// tensor descriptors) without reading any tensor data.
+func ParseFile(path string) (*Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gguf file: %w", err)
+	}
+	defer f.Close()
+
+	return Parse(bufio.NewReader(f))
+}
+
+// Parse reads a GGUF header from r.
+func Parse(r io.Reader) (*Model, error) {
+	var gotMagic [4]byte
+	if _, err := io.ReadFull(r, gotMagic[:]); err != nil {
+		return nil, fmt.Errorf("failed to read magic: %w", err)
+	}
+	if string(gotMagic[:]) != magic {
+		return nil, fmt.Errorf("not a gguf file: bad magic %q", gotMagic)
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, fmt.Errorf("failed to read version: %w", err)
+	}
+
+	var tensorCount, metadataCount uint64
+	if err := binary.Read(r, binary.LittleEndian, &tensorCount); err != nil {
+		return nil, fmt.Errorf("failed to read tensor count: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &metadataCount); err != nil {
+		return nil, fmt.Errorf("failed to read metadata count: %w", err)
+	}
+
+	metadata := make(map[string]interface{}, metadataCount)
+	for i := uint64(0); i < metadataCount; i++ {
+		key, err := readString(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata key %d: %w", i, err)
+		}
+		value, err := readValue(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata value for %q: %w", key, err)
+		}
+		metadata[key] = value
+	}
+
+	tensors := make([]TensorInfo, 0, tensorCount)
+	for i := uint64(0); i < tensorCount; i++ {
+		name, err := readString(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tensor name %d: %w", i, err)
+		}
+
+		var numDims uint32
+		if err := binary.Read(r, binary.LittleEndian, &numDims); err != nil {
+			return nil, fmt.Errorf("failed to read tensor dims for %q: %w", name, err)
+		}
+		shape := make([]uint64, numDims)
+		for d := range shape {
+			if err := binary.Read(r, binary.LittleEndian, &shape[d]); err != nil {
+				return nil, fmt.Errorf("failed to read tensor shape for %q: %w", name, err)
+			}
+		}
+
+		var ggmlType uint32
+		if err := binary.Read(r, binary.LittleEndian, &ggmlType); err != nil {
+			return nil, fmt.Errorf("failed to read tensor type for %q: %w", name, err)
+		}
+		var offset uint64
+		if err := binary.Read(r, binary.LittleEndian, &offset); err != nil {
+			return nil, fmt.Errorf("failed to read tensor offset for %q: %w", name, err)
+		}
+
+		tensors = append(tensors, TensorInfo{Name: name, Type: ggmlType, Shape: shape, Offset: offset})
+	}
+
+	model := &Model{Metadata: metadata, Tensors: tensors}
+	arch, _ := metadata["general.architecture"].(string)
+	model.Architecture = arch
+	model.ContextLength = metadataUint(metadata, arch+".context_length")
+	model.EmbeddingLength = metadataUint(metadata, arch+".embedding_length")
+	model.BlockCount = metadataUint(metadata, arch+".block_count")
+
+	return model, nil
+}
+
+// metadataUint reads a numeric KV entry regardless of which integer width it
+// was stored as.
+func metadataUint(metadata map[string]interface{}, key string) uint64 {
+	switch v := metadata[key].(type) {
+	case uint64:
+		return v
+	case uint32:
+		return uint64(v)
+	case int64:
+		return uint64(v)
+	case int32:
+		return uint64(v)
+	default:
+		return 0
+	}
+}
+
+func readString(r io.Reader) (string, error) {
+	var length uint64
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func readValue(r io.Reader) (interface{}, error) {
+	var t valueType
+	if err := binary.Read(r, binary.LittleEndian, &t); err != nil {
+		return nil, err
+	}
+	return readValueOfType(r, t)
+}
+
+func readValueOfType(r io.Reader, t valueType) (interface{}, error) {
+	switch t {
+	case typeUint8:
+		var v uint8
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeInt8:
+		var v int8
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeUint16:
+		var v uint16
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeInt16:
+		var v int16
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeUint32:
+		var v uint32
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeInt32:
+		var v int32
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeFloat32:
+		var v float32
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeBool:
+		var v uint8
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v != 0, err
+	case typeString:
+		return readString(r)
+	case typeUint64:
+		var v uint64
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeInt64:
+		var v int64
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeFloat64:
+		var v float64
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return v, err
+	case typeArray:
+		var elemType valueType
+		if err := binary.Read(r, binary.LittleEndian, &elemType); err != nil {
+			return nil, err
+		}
+		var length uint64
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, length)
+		for i := range values {
+			v, err := readValueOfType(r, elemType)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported gguf value type %d", t)
+	}
+}
+
+// BlobPath resolves the cached blob path for modelName (e.g. "llama3.1:8b")
+// under ollamaHome (typically "~/.ollama") by reading its manifest and
+// returning the digest of the layer whose media type identifies it as the
+// model weights.
+func BlobPath(ollamaHome, modelName string) (string, error) {
+	name, tag := modelName, "latest"
+	if idx := strings.LastIndex(modelName, ":"); idx != -1 {
+		name, tag = modelName[:idx], modelName[idx+1:]
+	}
+	if !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+
+	manifestPath := fmt.Sprintf("%s/models/manifests/registry.ollama.ai/%s/%s", ollamaHome, name, tag)
+	manifest, err := readManifest(manifestPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read manifest for %q: %w", modelName, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == "application/vnd.ollama.image.model" {
+			digest := strings.Replace(layer.Digest, ":", "-", 1)
+			return fmt.Sprintf("%s/models/blobs/%s", ollamaHome, digest), nil
+		}
+	}
+
+	return "", fmt.Errorf("no model layer found in manifest for %q", modelName)
+}
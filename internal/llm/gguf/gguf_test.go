@@ -0,0 +1,128 @@
+package gguf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// buildGGUF assembles a minimal-but-valid GGUF byte stream: a magic/version
+// header, one string metadata KV pair and one uint32 KV pair, and one
+// tensor descriptor with a two-dimensional shape.
+func buildGGUF(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	write(t, &buf, uint32(3)) // version
+	write(t, &buf, uint64(1)) // tensor count
+	write(t, &buf, uint64(2)) // metadata count
+
+	writeKVString(t, &buf, "general.architecture", "llama")
+	writeKVUint32(t, &buf, "llama.context_length", 8192)
+
+	writeString(t, &buf, "token_embd.weight")
+	write(t, &buf, uint32(2))     // numDims
+	write(t, &buf, uint64(4096))  // shape[0]
+	write(t, &buf, uint64(32000)) // shape[1]
+	write(t, &buf, uint32(0))     // ggml type
+	write(t, &buf, uint64(0))     // offset
+
+	return buf.Bytes()
+}
+
+func write(t *testing.T, buf *bytes.Buffer, v interface{}) {
+	t.Helper()
+	if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+		t.Fatalf("binary.Write() error = %v", err)
+	}
+}
+
+func writeString(t *testing.T, buf *bytes.Buffer, s string) {
+	t.Helper()
+	write(t, buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func writeKVString(t *testing.T, buf *bytes.Buffer, key, value string) {
+	t.Helper()
+	writeString(t, buf, key)
+	write(t, buf, uint32(typeString))
+	writeString(t, buf, value)
+}
+
+func writeKVUint32(t *testing.T, buf *bytes.Buffer, key string, value uint32) {
+	t.Helper()
+	writeString(t, buf, key)
+	write(t, buf, uint32(typeUint32))
+	write(t, buf, value)
+}
+
+func TestParseReadsHeaderMetadataAndTensors(t *testing.T) {
+	model, err := Parse(bytes.NewReader(buildGGUF(t)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if model.Architecture != "llama" {
+		t.Errorf("Architecture = %q, want %q", model.Architecture, "llama")
+	}
+	if model.ContextLength != 8192 {
+		t.Errorf("ContextLength = %d, want 8192", model.ContextLength)
+	}
+	if len(model.Tensors) != 1 {
+		t.Fatalf("len(Tensors) = %d, want 1", len(model.Tensors))
+	}
+
+	tensor := model.Tensors[0]
+	if tensor.Name != "token_embd.weight" {
+		t.Errorf("Tensors[0].Name = %q, want %q", tensor.Name, "token_embd.weight")
+	}
+	if len(tensor.Shape) != 2 || tensor.Shape[0] != 4096 || tensor.Shape[1] != 32000 {
+		t.Errorf("Tensors[0].Shape = %v, want [4096 32000]", tensor.Shape)
+	}
+}
+
+func TestParseRejectsBadMagic(t *testing.T) {
+	_, err := Parse(strings.NewReader("NOPE1234"))
+	if err == nil {
+		t.Fatal("Parse() error = nil, want an error for bad magic")
+	}
+}
+
+func TestParseRejectsTruncatedInput(t *testing.T) {
+	full := buildGGUF(t)
+	_, err := Parse(bytes.NewReader(full[:len(full)-4]))
+	if err == nil {
+		t.Fatal("Parse() error = nil, want an error for truncated input")
+	}
+}
+
+func TestParseHandlesArrayValues(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	write(t, &buf, uint32(3))
+	write(t, &buf, uint64(0)) // tensor count
+	write(t, &buf, uint64(1)) // metadata count
+
+	writeString(t, &buf, "tokenizer.ggml.tokens")
+	write(t, &buf, uint32(typeArray))
+	write(t, &buf, uint32(typeString))
+	write(t, &buf, uint64(2))
+	writeString(t, &buf, "<s>")
+	writeString(t, &buf, "</s>")
+
+	model, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tokens, ok := model.Metadata["tokenizer.ggml.tokens"].([]interface{})
+	if !ok {
+		t.Fatalf("Metadata[tokenizer.ggml.tokens] = %#v, want []interface{}", model.Metadata["tokenizer.ggml.tokens"])
+	}
+	if len(tokens) != 2 || tokens[0] != "<s>" || tokens[1] != "</s>" {
+		t.Errorf("tokens = %v, want [<s> </s>]", tokens)
+	}
+}
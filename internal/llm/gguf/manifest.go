@@ -0,0 +1,32 @@
+package gguf
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// manifestLayer is one entry in an Ollama manifest's "layers" array.
+type manifestLayer struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+// manifest is the subset of Ollama's manifest JSON (stored under
+// models/manifests/registry.ollama.ai/<name>/<tag>) that BlobPath needs.
+type manifest struct {
+	Layers []manifestLayer `json:"layers"`
+}
+
+func readManifest(path string) (*manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,71 @@
+package gguf
+
+// ggmlTypeInfo holds the per-block byte size and element count used to
+// convert a tensor's element count into bytes for a given ggml_type.
+type ggmlTypeInfo struct {
+	blockSize int // elements per block
+	typeSize  int // bytes per block
+}
+
+// ggmlTypes covers the quantization formats Ollama's default model set
+// actually ships; unrecognized types fall back to an F16-sized estimate in
+// tensorBytes.
+var ggmlTypes = map[uint32]ggmlTypeInfo{
+	0:  {blockSize: 1, typeSize: 4},  // F32
+	1:  {blockSize: 1, typeSize: 2},  // F16
+	2:  {blockSize: 32, typeSize: 18}, // Q4_0
+	3:  {blockSize: 32, typeSize: 20}, // Q4_1
+	6:  {blockSize: 32, typeSize: 22}, // Q5_0
+	7:  {blockSize: 32, typeSize: 24}, // Q5_1
+	8:  {blockSize: 32, typeSize: 34}, // Q8_0
+	10: {blockSize: 256, typeSize: 84},  // Q2_K
+	11: {blockSize: 256, typeSize: 110}, // Q3_K
+	12: {blockSize: 256, typeSize: 144}, // Q4_K
+	13: {blockSize: 256, typeSize: 176}, // Q5_K
+	14: {blockSize: 256, typeSize: 210}, // Q6_K
+	24: {blockSize: 1, typeSize: 1},  // I8
+	25: {blockSize: 1, typeSize: 2},  // I16
+	26: {blockSize: 1, typeSize: 4},  // I32
+}
+
+// bytesPerKVElem is the width FauxFoundry assumes for each KV-cache element.
+// Ollama defaults the KV cache to F16 unless quantized caching is enabled.
+const bytesPerKVElem = 2
+
+// tensorBytes estimates a tensor's on-disk/in-memory size from its shape and
+// ggml_type, defaulting unknown types to F16 (2 bytes/element, no blocking).
+func tensorBytes(t TensorInfo) uint64 {
+	elems := uint64(1)
+	for _, dim := range t.Shape {
+		elems *= dim
+	}
+
+	info, ok := ggmlTypes[t.Type]
+	if !ok {
+		return elems * 2
+	}
+
+	blocks := (elems + uint64(info.blockSize) - 1) / uint64(info.blockSize)
+	return blocks * uint64(info.typeSize)
+}
+
+// EstimateMemoryBytes sums every tensor's estimated size plus a KV-cache
+// estimate (2 * n_layers * n_ctx * n_embd * bytes_per_elem, the standard
+// key+value cache footprint for a full-context generation) to approximate
+// the total VRAM a model needs to run without offloading to CPU.
+func (m *Model) EstimateMemoryBytes() uint64 {
+	var total uint64
+	for _, t := range m.Tensors {
+		total += tensorBytes(t)
+	}
+
+	kvCache := 2 * m.BlockCount * m.ContextLength * m.EmbeddingLength * bytesPerKVElem
+	return total + kvCache
+}
+
+// FitsInVRAM reports whether the model's estimated memory footprint fits
+// within bytesAvailable. A model that doesn't fit will be partially or
+// fully offloaded to CPU by Ollama, which is far slower.
+func (m *Model) FitsInVRAM(bytesAvailable uint64) bool {
+	return m.EstimateMemoryBytes() <= bytesAvailable
+}
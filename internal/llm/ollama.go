@@ -7,6 +7,9 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm/gguf"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
 // OllamaHealth represents the health status of Ollama
@@ -35,6 +38,41 @@ type ModelDetails struct {
 	Families          []string `json:"families"`
 	ParameterSize     string   `json:"parameter_size"`
 	QuantizationLevel string   `json:"quantization_level"`
+
+	// ContextLength and EstimatedMemoryBytes come from parsing the model's
+	// GGUF header directly, since /api/tags exposes neither.
+	ContextLength        uint64 `json:"context_length,omitempty"`
+	EstimatedMemoryBytes uint64 `json:"estimated_memory_bytes,omitempty"`
+}
+
+// FitsInVRAM reports whether this model's estimated memory footprint fits
+// within bytesAvailable. It returns true when EstimatedMemoryBytes hasn't
+// been populated (e.g. its GGUF header couldn't be parsed), since there's
+// nothing to warn about in that case.
+func (m OllamaModel) FitsInVRAM(bytesAvailable uint64) bool {
+	if m.Details.EstimatedMemoryBytes == 0 {
+		return true
+	}
+	return m.Details.EstimatedMemoryBytes <= bytesAvailable
+}
+
+// EnrichModelDetails parses modelName's cached GGUF blob under ollamaHome
+// (typically "~/.ollama") and fills in the details /api/tags can't: true
+// context length and an estimated VRAM footprint.
+func EnrichModelDetails(ollamaHome, modelName string, details *ModelDetails) error {
+	blobPath, err := gguf.BlobPath(ollamaHome, modelName)
+	if err != nil {
+		return fmt.Errorf("failed to locate blob for %q: %w", modelName, err)
+	}
+
+	model, err := gguf.ParseFile(blobPath)
+	if err != nil {
+		return fmt.Errorf("failed to parse gguf header for %q: %w", modelName, err)
+	}
+
+	details.ContextLength = model.ContextLength
+	details.EstimatedMemoryBytes = model.EstimateMemoryBytes()
+	return nil
 }
 
 // OllamaTagsResponse represents the response from /api/tags
@@ -47,6 +85,14 @@ type OllamaVersionResponse struct {
 	Version string `json:"version"`
 }
 
+// CheckModelHealth implements Client for OllamaClient by delegating to
+// CheckOllamaHealth against spec.Model.Endpoint. spec.Model.Provider is
+// ignored: a bare OllamaClient only ever talks to Ollama; ProviderClient
+// overrides this method to dispatch by provider instead.
+func (c *OllamaClient) CheckModelHealth(ctx context.Context, spec *types.Specification) (*OllamaHealth, error) {
+	return c.CheckOllamaHealth(ctx, spec.Model.Endpoint)
+}
+
 // CheckOllamaHealth performs a comprehensive health check of Ollama
 func (c *OllamaClient) CheckOllamaHealth(ctx context.Context, endpoint string) (*OllamaHealth, error) {
 	health := &OllamaHealth{
@@ -157,6 +203,49 @@ func (c *OllamaClient) getOllamaModels(ctx context.Context, endpoint string) ([]
 	return tagsResp.Models, nil
 }
 
+// OllamaRunningModel represents one entry from /api/ps: a model Ollama
+// currently has loaded into memory, as opposed to the merely-installed
+// models /api/tags lists.
+type OllamaRunningModel struct {
+	Name      string    `json:"name"`
+	Model     string    `json:"model"`
+	Size      int64     `json:"size"`
+	SizeVRAM  int64     `json:"size_vram"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// OllamaPsResponse represents the response from /api/ps.
+type OllamaPsResponse struct {
+	Models []OllamaRunningModel `json:"models"`
+}
+
+// RunningModels returns the models endpoint currently has loaded into
+// memory via /api/ps. pkg/llm.Farm polls this to approximate a node's
+// current load when choosing where to dispatch the next Generate call.
+func (c *OllamaClient) RunningModels(ctx context.Context, endpoint string) ([]OllamaRunningModel, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"/api/ps", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running models: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var psResp OllamaPsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&psResp); err != nil {
+		return nil, fmt.Errorf("failed to decode ps response: %w", err)
+	}
+
+	return psResp.Models, nil
+}
+
 // PullModel pulls a model from Ollama registry
 func (c *OllamaClient) PullModel(ctx context.Context, endpoint, modelName string) error {
 	pullReq := map[string]interface{}{
@@ -0,0 +1,437 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// buildPrompt renders spec and count into the single-shot generation prompt
+// every provider.Provider is sent. It's a free function rather than an
+// OllamaClient method so ProviderClient can build the same prompt for
+// hosted backends.
+func buildPrompt(spec *types.Specification, count int) string {
+	prompt := fmt.Sprintf(`Generate %d unique JSON records for %s.
+
+Each record should be a valid JSON object with the following fields:
+`, count, spec.Dataset.Domain)
+
+	for _, field := range spec.Dataset.Fields {
+		prompt += fmt.Sprintf("- %s (%s)", field.Name, field.Type)
+		if field.Required {
+			prompt += " [required]"
+		}
+		if field.Description != "" {
+			prompt += fmt.Sprintf(": %s", field.Description)
+		}
+		if field.Pattern != "" {
+			prompt += fmt.Sprintf(" (pattern: %s)", field.Pattern)
+		}
+		if len(field.Range) == 2 {
+			prompt += fmt.Sprintf(" (range: %d-%d)", field.Range[0], field.Range[1])
+		}
+		if len(field.Values) > 0 {
+			prompt += fmt.Sprintf(" (values: %v)", field.Values)
+		}
+		prompt += "\n"
+	}
+
+	if len(spec.Dataset.Schema) > 0 {
+		prompt += "\nEach record must additionally validate against this JSON Schema, which the request also passes as Ollama's structured-output \"format\" constraint:\n"
+		if encoded, err := json.MarshalIndent(spec.Dataset.Schema, "", "  "); err == nil {
+			prompt += string(encoded) + "\n"
+		}
+	}
+
+	prompt += `
+Requirements:
+- Each record must be unique
+- Output only valid JSON objects, one per line
+- Follow the field constraints exactly
+- Make the data realistic and diverse
+- Do not include any explanatory text
+
+Generate the records now:`
+
+	return prompt
+}
+
+// parseGeneratedRecords parses response (whatever an LLM returned for a
+// buildPrompt-built prompt) into records, regardless of which provider
+// produced it. logger receives the same structured events OllamaClient used
+// to log directly.
+//
+// Extraction uses the same jsonObjectExtractor GenerateStream feeds token
+// chunks to, run instead over the whole buffered response: it tracks brace
+// depth and string/escape state character-by-character, so it finds every
+// balanced top-level {...} object regardless of whether the model wrapped
+// them in a [...] array, pretty-printed them across multiple lines, emitted
+// them back-to-back with no separator, or interleaved explanatory text
+// between them — all of which a \n\n-split would miss.
+func parseGeneratedRecords(logger log.Logger, response string, spec *types.Specification) ([]types.Record, error) {
+	records := make([]types.Record, 0)
+
+	// Remove markdown formatting
+	response = strings.ReplaceAll(response, "```json", "")
+	response = strings.ReplaceAll(response, "```", "")
+
+	extractor := &jsonObjectExtractor{}
+	for _, candidate := range extractor.feed(response) {
+		var record types.Record
+		if err := json.Unmarshal([]byte(candidate), &record); err != nil {
+			// Try to fix common JSON issues
+			fixed := fixCommonJSONIssues(candidate)
+			if err := json.Unmarshal([]byte(fixed), &record); err != nil {
+				continue // Skip this record if we can't parse it
+			}
+		}
+
+		// Validate that the record has the expected fields (or, with a
+		// Dataset.Schema, that it validates against it).
+		if validateRecord(record, spec) {
+			records = append(records, record)
+		} else if len(spec.Dataset.Schema) > 0 {
+			if errs, err := validateRecordSchema(record, spec.Dataset.Schema); err == nil {
+				logger.Debug("rejected record failing schema validation", "errors", errs)
+			}
+		}
+	}
+
+	// If we couldn't parse any records from the LLM response, return an error
+	if len(records) == 0 {
+		logger.Error("failed to parse any records from LLM response",
+			"response_length", len(response),
+			"preview", response[:min(500, len(response))])
+		return nil, fmt.Errorf("could not parse any valid JSON records from LLM response")
+	}
+
+	logger.Debug("parsed records from LLM response", "records", len(records))
+	return records, nil
+}
+
+// unquotedKeyPattern matches an object key a model left unquoted, e.g.
+// {name: "Bob"} or {foo: 1, bar: 2}. It only fires right after a { or , (plus
+// whitespace), so an already-quoted key ({"name": ...}) never matches: the
+// character there is a ", not a bareword letter or underscore.
+var unquotedKeyPattern = regexp.MustCompile(`([{,]\s*)([A-Za-z_][A-Za-z0-9_]*)\s*:`)
+
+// singleQuotedStringPattern matches a '...'-delimited string a model used in
+// place of a "..."-delimited one, capturing its content (with any \' left
+// as-is for fixCommonJSONIssues' ReplaceAllStringFunc to unescape).
+var singleQuotedStringPattern = regexp.MustCompile(`'([^'\\]*(?:\\.[^'\\]*)*)'`)
+
+// fixCommonJSONIssues attempts to fix common JSON formatting issues in a
+// single candidate object fished out of an LLM response. It's a best-effort
+// set of fallback passes, not a parser: each one targets a specific mistake
+// models make and is skipped if it would do more harm than good elsewhere,
+// but none of them is guaranteed to produce valid JSON on every input.
+func fixCommonJSONIssues(jsonStr string) string {
+	jsonStr = stripJSONComments(jsonStr)
+
+	// Remove trailing commas before closing braces
+	jsonStr = strings.ReplaceAll(jsonStr, ",\n}", "\n}")
+	jsonStr = strings.ReplaceAll(jsonStr, ", }", " }")
+
+	// Quote bareword object keys: {name: "Bob"} -> {"name": "Bob"}
+	jsonStr = unquotedKeyPattern.ReplaceAllString(jsonStr, `$1"$2":`)
+
+	// Convert 'single-quoted' strings to "double-quoted" ones. This is
+	// imperfect for an apostrophe sitting inside an already-valid
+	// double-quoted string (e.g. "it's fine"), since the pattern above has
+	// no way to tell that value apart from a genuine single-quoted string;
+	// in practice models that single-quote at all do so consistently, so
+	// this trade-off rarely comes up.
+	jsonStr = singleQuotedStringPattern.ReplaceAllStringFunc(jsonStr, func(match string) string {
+		inner := match[1 : len(match)-1]
+		inner = strings.ReplaceAll(inner, `\'`, "'")
+		inner = strings.ReplaceAll(inner, `"`, `\"`)
+		return `"` + inner + `"`
+	})
+
+	return jsonStr
+}
+
+// stripJSONComments removes // line comments and /* */ block comments a
+// model sometimes adds despite being asked for plain JSON, leaving anything
+// inside a double-quoted string untouched.
+func stripJSONComments(jsonStr string) string {
+	var out strings.Builder
+	inString := false
+	escaped := false
+
+	for i := 0; i < len(jsonStr); i++ {
+		b := jsonStr[i]
+
+		if inString {
+			out.WriteByte(b)
+			switch {
+			case escaped:
+				escaped = false
+			case b == '\\':
+				escaped = true
+			case b == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case b == '"':
+			inString = true
+			out.WriteByte(b)
+		case b == '/' && i+1 < len(jsonStr) && jsonStr[i+1] == '/':
+			for i < len(jsonStr) && jsonStr[i] != '\n' {
+				i++
+			}
+			if i < len(jsonStr) {
+				out.WriteByte('\n')
+			}
+		case b == '/' && i+1 < len(jsonStr) && jsonStr[i+1] == '*':
+			i += 2
+			for i+1 < len(jsonStr) && !(jsonStr[i] == '*' && jsonStr[i+1] == '/') {
+				i++
+			}
+			i++
+		default:
+			out.WriteByte(b)
+		}
+	}
+
+	return out.String()
+}
+
+// validateRecord reports whether record is acceptable for spec. When
+// spec.Dataset.Schema is set, it's a real schema validation (see
+// validateRecordSchema); otherwise it falls back to the required-field-
+// count heuristic this function has always used.
+func validateRecord(record types.Record, spec *types.Specification) bool {
+	if len(spec.Dataset.Schema) > 0 {
+		errs, err := validateRecordSchema(record, spec.Dataset.Schema)
+		if err != nil {
+			// Schema failed to compile; fall through to the heuristic
+			// rather than rejecting every record over a spec problem
+			// Validate/ValidateDocument should have already caught.
+			return validateRecordFields(record, spec)
+		}
+		return len(errs) == 0
+	}
+	return validateRecordFields(record, spec)
+}
+
+// validateRecordFields checks that record contains the required fields
+// from spec.Dataset.Fields, without consulting a schema.
+func validateRecordFields(record types.Record, spec *types.Specification) bool {
+	requiredFields := 0
+	presentFields := 0
+
+	for _, field := range spec.Dataset.Fields {
+		if field.Required {
+			requiredFields++
+			if _, exists := record[field.Name]; exists {
+				presentFields++
+			}
+		}
+	}
+
+	// Require at least 80% of required fields to be present
+	if requiredFields > 0 {
+		return float64(presentFields)/float64(requiredFields) >= 0.8
+	}
+
+	// If no required fields, just check that we have some fields
+	return len(record) > 0
+}
+
+// datasetSchemaResource is the synthetic URI compileDatasetSchema registers
+// a dataset's Schema under. It's never fetched; it only gives the compiler
+// something to name the root document.
+const datasetSchemaResource = "urn:faux-foundry:dataset-schema"
+
+// compileDatasetSchema compiles schema (a dataset's Schema map, i.e. a JSON
+// Schema document or OpenAPI 3 components.schemas entry) for validating
+// generated records against it directly. It recompiles on every call rather
+// than caching, since a different schema arrives with every Specification;
+// callers that validate many records against the same spec should compile
+// once and reuse the result.
+func compileDatasetSchema(schema map[string]interface{}) (*jsonschema.Schema, error) {
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dataset schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	if err := compiler.AddResource(datasetSchemaResource, bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("failed to load dataset schema: %w", err)
+	}
+	return compiler.Compile(datasetSchemaResource)
+}
+
+// validateRecordSchema validates record against schema (compiled fresh via
+// compileDatasetSchema), returning one types.ValidationError per violation.
+// A *jsonschema.ValidationError's Causes carry one entry per independent
+// failure (e.g. two different properties both out of range); a leaf
+// violation with no Causes of its own is reported as a single error whose
+// Field is its JSON-Pointer InstanceLocation.
+func validateRecordSchema(record types.Record, schema map[string]interface{}) ([]types.ValidationError, error) {
+	compiled, err := compileDatasetSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal record: %w", err)
+	}
+	var instance interface{}
+	if err := json.Unmarshal(raw, &instance); err != nil {
+		return nil, fmt.Errorf("failed to decode record: %w", err)
+	}
+
+	err = compiled.Validate(instance)
+	if err == nil {
+		return nil, nil
+	}
+
+	valErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []types.ValidationError{{Message: err.Error()}}, nil
+	}
+
+	causes := valErr.Causes
+	if len(causes) == 0 {
+		causes = []*jsonschema.ValidationError{valErr}
+	}
+
+	errs := make([]types.ValidationError, 0, len(causes))
+	for _, cause := range causes {
+		errs = append(errs, types.ValidationError{
+			Field:   cause.InstanceLocation,
+			Message: cause.Message,
+		})
+	}
+	return errs, nil
+}
+
+// maxPatternRepeat bounds how many times generatePatternString will expand
+// an unbounded repeat (`*`, `+`, or a `{n,}`/`{n,m}` with a large m) in a
+// single AST node, so a pathological pattern can't make generation hang or
+// produce an unreasonably long value.
+const maxPatternRepeat = 32
+
+// GeneratePatternString is generatePatternString exported for callers
+// outside this package, e.g. internal/agents' regex_sample tool, that want
+// the same regex-AST-driven string synthesis this package already uses for
+// pattern-constrained fields.
+func GeneratePatternString(pattern string, seed int) string {
+	return generatePatternString(pattern, seed)
+}
+
+// generatePatternString generates a string matching pattern, deterministically
+// from seed: parse it with regexp/syntax and walk the resulting AST, emitting
+// literals, char classes, concatenation, alternation, and repeats directly
+// rather than maintaining a hand-written table of known patterns. This lets
+// a spec's field.Pattern be any regexp — a Luhn-shaped card prefix, a custom
+// ID, a license plate — without a matching case added here first.
+//
+// If pattern fails to parse, the original hardcoded-table behavior's
+// fallback is preserved: a generic "pattern_match_<seed>" placeholder.
+func generatePatternString(pattern string, seed int) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return fmt.Sprintf("pattern_match_%d", seed)
+	}
+
+	rng := rand.New(rand.NewSource(int64(seed)))
+	var out strings.Builder
+	writePatternNode(&out, re, rng)
+	return out.String()
+}
+
+// writePatternNode renders a single regexp/syntax.Regexp AST node (and its
+// subexpressions) onto out, consuming rng for anything with more than one
+// valid expansion (a char class, an alternation branch, a repeat count).
+func writePatternNode(out *strings.Builder, re *syntax.Regexp, rng *rand.Rand) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			out.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		out.WriteRune(randRuneFromClass(re.Rune, rng))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		out.WriteRune(rune('a' + rng.Intn(26)))
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writePatternNode(out, sub, rng)
+		}
+	case syntax.OpAlternate:
+		writePatternNode(out, re.Sub[rng.Intn(len(re.Sub))], rng)
+	case syntax.OpCapture:
+		writePatternNode(out, re.Sub[0], rng)
+	case syntax.OpStar:
+		writePatternRepeat(out, re.Sub[0], rng, 0, maxPatternRepeat)
+	case syntax.OpPlus:
+		writePatternRepeat(out, re.Sub[0], rng, 1, maxPatternRepeat)
+	case syntax.OpQuest:
+		writePatternRepeat(out, re.Sub[0], rng, 0, 1)
+	case syntax.OpRepeat:
+		max := re.Max
+		if max < 0 || max > maxPatternRepeat {
+			max = maxPatternRepeat
+		}
+		writePatternRepeat(out, re.Sub[0], rng, re.Min, max)
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary, syntax.OpEmptyMatch:
+		// Anchors and zero-width assertions contribute no characters.
+	default:
+		// OpNoMatch and anything else unsupported: contribute nothing
+		// rather than guessing, same spirit as the old default case.
+	}
+}
+
+// writePatternRepeat renders sub between min and max times (inclusive),
+// picking a count uniformly in that range via rng.
+func writePatternRepeat(out *strings.Builder, sub *syntax.Regexp, rng *rand.Rand, min, max int) {
+	if max < min {
+		max = min
+	}
+	n := min
+	if max > min {
+		n += rng.Intn(max - min + 1)
+	}
+	for i := 0; i < n; i++ {
+		writePatternNode(out, sub, rng)
+	}
+}
+
+// randRuneFromClass picks a uniformly random rune from ranges, a
+// syntax.Regexp.Rune char-class ([lo0,hi0,lo1,hi1,...] rune pairs).
+func randRuneFromClass(ranges []rune, rng *rand.Rand) rune {
+	var total int64
+	for i := 0; i < len(ranges); i += 2 {
+		total += int64(ranges[i+1]-ranges[i]) + 1
+	}
+	if total <= 0 {
+		return '_'
+	}
+
+	pick := rng.Int63n(total)
+	for i := 0; i < len(ranges); i += 2 {
+		width := int64(ranges[i+1]-ranges[i]) + 1
+		if pick < width {
+			return ranges[i] + rune(pick)
+		}
+		pick -= width
+	}
+	return ranges[0]
+}
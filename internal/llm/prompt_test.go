@@ -0,0 +1,50 @@
+package llm
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestGeneratePatternStringMatchesPattern(t *testing.T) {
+	patterns := []string{
+		`[A-Z]{3}-\d{4}`,
+		`(foo|bar|baz)`,
+		`[a-f0-9]{8}`,
+		`a+b?c*`,
+	}
+
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(`^` + pattern + `$`)
+		for seed := 0; seed < 20; seed++ {
+			got := GeneratePatternString(pattern, seed)
+			if !re.MatchString(got) {
+				t.Errorf("GeneratePatternString(%q, %d) = %q, does not match pattern", pattern, seed, got)
+			}
+		}
+	}
+}
+
+func TestGeneratePatternStringIsDeterministicForSameSeed(t *testing.T) {
+	const pattern = `[A-Z]{5}\d{3}`
+
+	first := GeneratePatternString(pattern, 42)
+	second := GeneratePatternString(pattern, 42)
+
+	if first != second {
+		t.Errorf("GeneratePatternString with the same seed produced %q then %q, want identical output", first, second)
+	}
+}
+
+func TestGeneratePatternStringFallsBackOnInvalidPattern(t *testing.T) {
+	got := GeneratePatternString(`[invalid(`, 7)
+	if got != "pattern_match_7" {
+		t.Errorf("GeneratePatternString on an unparseable pattern = %q, want the placeholder fallback", got)
+	}
+}
+
+func TestGeneratePatternStringBoundsUnboundedRepeats(t *testing.T) {
+	got := GeneratePatternString(`a*`, 1)
+	if len(got) > maxPatternRepeat {
+		t.Errorf("GeneratePatternString(%q) produced %d chars, want at most maxPatternRepeat (%d)", "a*", len(got), maxPatternRepeat)
+	}
+}
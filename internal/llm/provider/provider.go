@@ -0,0 +1,96 @@
+// Package provider defines the pluggable backend abstraction used to
+// generate synthetic data against local or hosted LLMs. Concrete backends
+// (Ollama, OpenAI-compatible, Anthropic, Gemini) live in sibling packages
+// under internal/llm/providers and register themselves via Register.
+package provider
+
+import "context"
+
+// Config carries the connection settings needed to construct a Provider,
+// resolved from a spec's ModelConfig or from CLI/TUI settings.
+type Config struct {
+	Endpoint    string
+	APIKey      string
+	Model       string
+	Temperature float64
+}
+
+// Message is a provider-agnostic chat message.
+type Message struct {
+	Role    string
+	Content string
+}
+
+// GenerateRequest is a provider-agnostic single-prompt generation request.
+type GenerateRequest struct {
+	Prompt      string
+	Temperature float64
+}
+
+// GenerateResult is a provider-agnostic single-prompt generation result.
+type GenerateResult struct {
+	Text string
+}
+
+// ChatRequest is a provider-agnostic multi-turn chat request.
+type ChatRequest struct {
+	Messages    []Message
+	Temperature float64
+}
+
+// ChatResult is a provider-agnostic chat result.
+type ChatResult struct {
+	Message Message
+}
+
+// StreamChunk is a single piece of a streaming generation response.
+type StreamChunk struct {
+	Text string
+	Done bool
+	Err  error
+}
+
+// Provider is implemented by every LLM backend FauxFoundry can generate
+// against, whether local (Ollama) or hosted (OpenAI-compatible, Anthropic,
+// Gemini).
+type Provider interface {
+	// Name returns the provider's registry key, e.g. "ollama" or "anthropic".
+	Name() string
+	// Health reports whether the provider is reachable and usable.
+	Health(ctx context.Context) error
+	// ListModels returns the models available from this provider.
+	ListModels(ctx context.Context) ([]string, error)
+	// Generate produces a single text completion.
+	Generate(ctx context.Context, req GenerateRequest) (GenerateResult, error)
+	// Chat produces a single chat completion.
+	Chat(ctx context.Context, req ChatRequest) (ChatResult, error)
+	// Stream produces a channel of incremental generation chunks.
+	Stream(ctx context.Context, req GenerateRequest) (<-chan StreamChunk, error)
+}
+
+// Factory constructs a Provider from resolved connection config.
+type Factory func(cfg Config) (Provider, error)
+
+var registry = make(map[string]Factory)
+
+// Register adds a provider factory under name so it can later be selected
+// by config (spec.Model.Provider, a CLI flag, or UserSettings). Register is
+// typically called from a provider package's init().
+func Register(name string, factory Factory) {
+	registry[name] = factory
+}
+
+// Get looks up a registered factory by name.
+func Get(name string) (Factory, bool) {
+	factory, ok := registry[name]
+	return factory, ok
+}
+
+// Names returns the currently registered provider names.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,277 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm/provider"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/anthropic"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/gemini"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/openai"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// apiKeyEnvVars maps a provider.Provider registry name to the conventional
+// environment variable resolveAPIKey falls back to when a spec leaves
+// model.api_key empty. Ollama isn't listed: it doesn't authenticate.
+var apiKeyEnvVars = map[string]string{
+	"openai":            "OPENAI_API_KEY",
+	"openai-compatible": "OPENAI_API_KEY",
+	"anthropic":         "ANTHROPIC_API_KEY",
+	"google":            "GOOGLE_API_KEY",
+	"gemini":            "GOOGLE_API_KEY",
+}
+
+// resolveAPIKey returns explicit if set, otherwise the value of
+// providerName's conventional environment variable (empty if there isn't
+// one, or it isn't set).
+func resolveAPIKey(providerName, explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if envVar, ok := apiKeyEnvVars[providerName]; ok {
+		return os.Getenv(envVar)
+	}
+	return ""
+}
+
+// ProviderClient is the Client every FauxFoundry entry point should
+// construct: for specs that leave model.provider empty (or set it to
+// "ollama") it behaves exactly like the embedded OllamaClient, timeout
+// handler and all, so existing local-model deployments see no behavior
+// change. For any other provider it goes through the internal/llm/provider
+// registry instead, using the same prompt and response-parsing logic
+// (buildPrompt, parseGeneratedRecords) against a simpler exponential-backoff
+// retry loop, since the OllamaClient's batch-reduction/simplification
+// strategies are tuned for local-model resource limits hosted APIs don't
+// have.
+type ProviderClient struct {
+	*OllamaClient
+}
+
+// NewProviderClient wraps a new OllamaClient in a ProviderClient.
+func NewProviderClient() *ProviderClient {
+	return &ProviderClient{OllamaClient: NewOllamaClient()}
+}
+
+// Generate implements Client, dispatching to the provider named by
+// spec.Model.Provider.
+func (c *ProviderClient) Generate(ctx context.Context, spec *types.Specification, count int) ([]types.Record, error) {
+	if isOllama(spec.Model.Provider) {
+		return c.OllamaClient.Generate(ctx, spec, count)
+	}
+	return c.generateFromProvider(ctx, spec, count, DefaultRetryConfig())
+}
+
+// GenerateWithConfig implements Client, dispatching to the provider named by
+// spec.Model.Provider.
+func (c *ProviderClient) GenerateWithConfig(ctx context.Context, spec *types.Specification, count int, config *RetryConfig) ([]types.Record, error) {
+	if isOllama(spec.Model.Provider) {
+		return c.OllamaClient.GenerateWithConfig(ctx, spec, count, config)
+	}
+	return c.generateFromProvider(ctx, spec, count, config)
+}
+
+// GenerateStream implements Client, dispatching to the provider named by
+// spec.Model.Provider.
+func (c *ProviderClient) GenerateStream(ctx context.Context, spec *types.Specification, count int) (<-chan types.Record, <-chan error) {
+	if isOllama(spec.Model.Provider) {
+		return c.OllamaClient.GenerateStream(ctx, spec, count)
+	}
+	return c.streamFromProvider(ctx, spec, count)
+}
+
+// CheckModelHealth implements Client, dispatching to the provider named by
+// spec.Model.Provider. For a hosted provider, IsRunning reflects Health and
+// Models comes from ListModels; Version and LastChecked are left zero since
+// provider.Provider exposes neither.
+func (c *ProviderClient) CheckModelHealth(ctx context.Context, spec *types.Specification) (*OllamaHealth, error) {
+	if isOllama(spec.Model.Provider) {
+		return c.OllamaClient.CheckOllamaHealth(ctx, spec.Model.Endpoint)
+	}
+
+	health := &OllamaHealth{Endpoint: spec.Model.Endpoint}
+
+	factory, ok := provider.Get(spec.Model.Provider)
+	if !ok {
+		health.ErrorMessage = fmt.Sprintf("unknown provider %q (available: %v)", spec.Model.Provider, provider.Names())
+		return health, nil
+	}
+
+	p, err := factory(provider.Config{
+		Endpoint:    spec.Model.Endpoint,
+		APIKey:      resolveAPIKey(spec.Model.Provider, spec.Model.APIKey),
+		Model:       spec.Model.Name,
+		Temperature: spec.Model.Temperature,
+	})
+	if err != nil {
+		health.ErrorMessage = fmt.Sprintf("failed to construct %s provider: %v", spec.Model.Provider, err)
+		return health, nil
+	}
+
+	if err := p.Health(ctx); err != nil {
+		health.ErrorMessage = fmt.Sprintf("health check failed: %v", err)
+		return health, nil
+	}
+	health.IsRunning = true
+
+	models, err := p.ListModels(ctx)
+	if err != nil {
+		health.ErrorMessage = fmt.Sprintf("failed to list models: %v", err)
+		return health, nil
+	}
+	health.Models = models
+
+	return health, nil
+}
+
+// streamFromProvider streams count records from spec.Model.Provider's
+// provider.Provider.Stream, running the same streamed-response object
+// extraction GenerateStream uses for Ollama.
+func (c *ProviderClient) streamFromProvider(ctx context.Context, spec *types.Specification, count int) (<-chan types.Record, <-chan error) {
+	records := make(chan types.Record)
+	errs := make(chan error, 1)
+
+	factory, ok := provider.Get(spec.Model.Provider)
+	if !ok {
+		errs <- fmt.Errorf("unknown provider %q (available: %v)", spec.Model.Provider, provider.Names())
+		close(records)
+		close(errs)
+		return records, errs
+	}
+
+	p, err := factory(provider.Config{
+		Endpoint:    spec.Model.Endpoint,
+		APIKey:      resolveAPIKey(spec.Model.Provider, spec.Model.APIKey),
+		Model:       spec.Model.Name,
+		Temperature: spec.Model.Temperature,
+	})
+	if err != nil {
+		errs <- fmt.Errorf("failed to construct %s provider: %w", spec.Model.Provider, err)
+		close(records)
+		close(errs)
+		return records, errs
+	}
+
+	chunks, err := p.Stream(ctx, provider.GenerateRequest{Prompt: buildPrompt(spec, count), Temperature: spec.Model.Temperature})
+	if err != nil {
+		errs <- err
+		close(records)
+		close(errs)
+		return records, errs
+	}
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		extractor := &jsonObjectExtractor{}
+		for chunk := range chunks {
+			if chunk.Err != nil {
+				errs <- chunk.Err
+				return
+			}
+
+			for _, raw := range extractor.feed(chunk.Text) {
+				var record types.Record
+				if jsonErr := json.Unmarshal([]byte(raw), &record); jsonErr != nil {
+					fixed := fixCommonJSONIssues(raw)
+					if jsonErr := json.Unmarshal([]byte(fixed), &record); jsonErr != nil {
+						c.Logger.Debug("skipping unparseable streamed object", "provider", spec.Model.Provider, "error", jsonErr)
+						continue
+					}
+				}
+
+				if !validateRecord(record, spec) {
+					continue
+				}
+
+				select {
+				case records <- record:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return records, errs
+}
+
+// isOllama reports whether providerName selects the local Ollama path, which
+// is both the zero value and the explicit "ollama" name.
+func isOllama(providerName string) bool {
+	return providerName == "" || providerName == "ollama"
+}
+
+// generateFromProvider generates count records against the hosted provider
+// named by spec.Model.Provider, retrying transient failures with
+// exponential backoff until config's retry budget is exhausted.
+func (c *ProviderClient) generateFromProvider(ctx context.Context, spec *types.Specification, count int, config *RetryConfig) ([]types.Record, error) {
+	factory, ok := provider.Get(spec.Model.Provider)
+	if !ok {
+		return nil, fmt.Errorf("unknown provider %q (available: %v)", spec.Model.Provider, provider.Names())
+	}
+
+	p, err := factory(provider.Config{
+		Endpoint:    spec.Model.Endpoint,
+		APIKey:      resolveAPIKey(spec.Model.Provider, spec.Model.APIKey),
+		Model:       spec.Model.Name,
+		Temperature: spec.Model.Temperature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct %s provider: %w", spec.Model.Provider, err)
+	}
+
+	backoff := &ExponentialBackoff{
+		InitialInterval:     config.BaseTimeout,
+		MaxInterval:         config.MaxTimeout,
+		Multiplier:          config.BackoffMultiplier,
+		RandomizationFactor: config.RandomizationFactor,
+		MaxElapsedTime:      config.MaxElapsedTime,
+	}
+	backoff.Reset()
+
+	prompt := buildPrompt(spec, count)
+
+	var lastErr error
+	attempts := 0
+	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			wait := backoff.NextBackoff()
+			if wait == BackoffStop {
+				break
+			}
+			c.Logger.Warn("retrying provider generation", "provider", spec.Model.Provider, "attempt", attempt, "wait", wait, "error", lastErr)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		attempts++
+		result, err := p.Generate(ctx, provider.GenerateRequest{Prompt: prompt, Temperature: spec.Model.Temperature})
+		if err != nil {
+			if IsPermanent(err) {
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+
+		records, err := parseGeneratedRecords(c.Logger, result.Text, spec)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		c.Logger.Info("generated records from provider", "provider", spec.Model.Provider, "records", len(records))
+		return records, nil
+	}
+
+	return nil, fmt.Errorf("generation via %s failed after %d attempts: %w", spec.Model.Provider, attempts, lastErr)
+}
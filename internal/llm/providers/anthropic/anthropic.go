@@ -0,0 +1,164 @@
+// Package anthropic implements provider.Provider against Anthropic's
+// Messages API.
+package anthropic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm/provider"
+)
+
+const (
+	defaultEndpoint  = "https://api.anthropic.com/v1"
+	anthropicVersion = "2023-06-01"
+	defaultMaxTokens = 4096
+)
+
+func init() {
+	provider.Register("anthropic", New)
+}
+
+// Provider talks to Anthropic's Messages API.
+type Provider struct {
+	httpClient *http.Client
+	endpoint   string
+	apiKey     string
+	model      string
+}
+
+// New constructs an Anthropic provider.Provider from cfg.
+func New(cfg provider.Config) (provider.Provider, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	return &Provider{
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		endpoint:   endpoint,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+	}, nil
+}
+
+// Name implements provider.Provider.
+func (p *Provider) Name() string { return "anthropic" }
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type messagesRequest struct {
+	Model       string    `json:"model"`
+	Messages    []message `json:"messages"`
+	MaxTokens   int       `json:"max_tokens"`
+	Temperature float64   `json:"temperature,omitempty"`
+}
+
+type messagesResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Health implements provider.Provider with a minimal chat round-trip, since
+// Anthropic exposes no dedicated health endpoint.
+func (p *Provider) Health(ctx context.Context) error {
+	_, err := p.Chat(ctx, provider.ChatRequest{Messages: []provider.Message{{Role: "user", Content: "ping"}}})
+	return err
+}
+
+// ListModels returns the Claude model names FauxFoundry has been validated
+// against; Anthropic has no public model-listing endpoint.
+func (p *Provider) ListModels(ctx context.Context) ([]string, error) {
+	return []string{"claude-3-5-sonnet-latest", "claude-3-5-haiku-latest", "claude-3-opus-latest"}, nil
+}
+
+// Generate implements provider.Provider.
+func (p *Provider) Generate(ctx context.Context, req provider.GenerateRequest) (provider.GenerateResult, error) {
+	result, err := p.Chat(ctx, provider.ChatRequest{
+		Messages:    []provider.Message{{Role: "user", Content: req.Prompt}},
+		Temperature: req.Temperature,
+	})
+	if err != nil {
+		return provider.GenerateResult{}, err
+	}
+	return provider.GenerateResult{Text: result.Message.Content}, nil
+}
+
+// Chat implements provider.Provider.
+func (p *Provider) Chat(ctx context.Context, req provider.ChatRequest) (provider.ChatResult, error) {
+	messages := make([]message, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = message{Role: m.Role, Content: m.Content}
+	}
+
+	body, err := json.Marshal(messagesRequest{
+		Model:       p.model,
+		Messages:    messages,
+		MaxTokens:   defaultMaxTokens,
+		Temperature: req.Temperature,
+	})
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", p.endpoint+"/messages", bytes.NewReader(body))
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", p.apiKey)
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return provider.ChatResult{}, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var completion messagesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if completion.Error != nil {
+		return provider.ChatResult{}, fmt.Errorf("anthropic error: %s", completion.Error.Message)
+	}
+
+	if len(completion.Content) == 0 {
+		return provider.ChatResult{}, fmt.Errorf("no content returned")
+	}
+
+	return provider.ChatResult{
+		Message: provider.Message{Role: "assistant", Content: completion.Content[0].Text},
+	}, nil
+}
+
+// Stream implements provider.Provider. Anthropic's SSE stream isn't
+// consumed yet, so this emulates streaming with a single terminal chunk.
+func (p *Provider) Stream(ctx context.Context, req provider.GenerateRequest) (<-chan provider.StreamChunk, error) {
+	result, err := p.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan provider.StreamChunk, 1)
+	out <- provider.StreamChunk{Text: result.Text, Done: true}
+	close(out)
+	return out, nil
+}
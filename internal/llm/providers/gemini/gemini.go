@@ -0,0 +1,169 @@
+// Package gemini implements provider.Provider against Google's Generative
+// Language API.
+package gemini
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm/provider"
+)
+
+const defaultEndpoint = "https://generativelanguage.googleapis.com/v1beta"
+
+func init() {
+	provider.Register("gemini", New)
+	provider.Register("google", New)
+}
+
+// Provider talks to Google's Generative Language API (Gemini).
+type Provider struct {
+	httpClient *http.Client
+	endpoint   string
+	apiKey     string
+	model      string
+}
+
+// New constructs a Gemini provider.Provider from cfg.
+func New(cfg provider.Config) (provider.Provider, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	return &Provider{
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		endpoint:   endpoint,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+	}, nil
+}
+
+// Name implements provider.Provider.
+func (p *Provider) Name() string { return "gemini" }
+
+type part struct {
+	Text string `json:"text"`
+}
+
+type content struct {
+	Role  string `json:"role,omitempty"`
+	Parts []part `json:"parts"`
+}
+
+type generationConfig struct {
+	Temperature float64 `json:"temperature,omitempty"`
+}
+
+type generateContentRequest struct {
+	Contents         []content        `json:"contents"`
+	GenerationConfig generationConfig `json:"generationConfig,omitempty"`
+}
+
+type generateContentResponse struct {
+	Candidates []struct {
+		Content content `json:"content"`
+	} `json:"candidates"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Health implements provider.Provider with a minimal chat round-trip, since
+// Gemini exposes no dedicated health endpoint.
+func (p *Provider) Health(ctx context.Context) error {
+	_, err := p.Chat(ctx, provider.ChatRequest{Messages: []provider.Message{{Role: "user", Content: "ping"}}})
+	return err
+}
+
+// ListModels returns the Gemini model names FauxFoundry has been validated
+// against.
+func (p *Provider) ListModels(ctx context.Context) ([]string, error) {
+	return []string{"gemini-1.5-pro", "gemini-1.5-flash", "gemini-2.0-flash"}, nil
+}
+
+// Generate implements provider.Provider.
+func (p *Provider) Generate(ctx context.Context, req provider.GenerateRequest) (provider.GenerateResult, error) {
+	result, err := p.Chat(ctx, provider.ChatRequest{
+		Messages:    []provider.Message{{Role: "user", Content: req.Prompt}},
+		Temperature: req.Temperature,
+	})
+	if err != nil {
+		return provider.GenerateResult{}, err
+	}
+	return provider.GenerateResult{Text: result.Message.Content}, nil
+}
+
+// Chat implements provider.Provider.
+func (p *Provider) Chat(ctx context.Context, req provider.ChatRequest) (provider.ChatResult, error) {
+	contents := make([]content, len(req.Messages))
+	for i, m := range req.Messages {
+		role := m.Role
+		if role == "assistant" {
+			role = "model"
+		}
+		contents[i] = content{Role: role, Parts: []part{{Text: m.Content}}}
+	}
+
+	body, err := json.Marshal(generateContentRequest{
+		Contents:         contents,
+		GenerationConfig: generationConfig{Temperature: req.Temperature},
+	})
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/models/%s:generateContent?key=%s", p.endpoint, p.model, p.apiKey)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return provider.ChatResult{}, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var completion generateContentResponse
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if completion.Error != nil {
+		return provider.ChatResult{}, fmt.Errorf("gemini error: %s", completion.Error.Message)
+	}
+
+	if len(completion.Candidates) == 0 || len(completion.Candidates[0].Content.Parts) == 0 {
+		return provider.ChatResult{}, fmt.Errorf("no candidates returned")
+	}
+
+	return provider.ChatResult{
+		Message: provider.Message{Role: "assistant", Content: completion.Candidates[0].Content.Parts[0].Text},
+	}, nil
+}
+
+// Stream implements provider.Provider. The generateContent API's streaming
+// variant isn't consumed yet, so this emulates streaming with a single
+// terminal chunk.
+func (p *Provider) Stream(ctx context.Context, req provider.GenerateRequest) (<-chan provider.StreamChunk, error) {
+	result, err := p.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan provider.StreamChunk, 1)
+	out <- provider.StreamChunk{Text: result.Text, Done: true}
+	close(out)
+	return out, nil
+}
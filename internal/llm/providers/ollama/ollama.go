@@ -0,0 +1,105 @@
+// Package ollama adapts the existing llm.OllamaClient to the
+// provider.Provider interface so Ollama can be selected through the same
+// registry as hosted backends.
+package ollama
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/llm/provider"
+)
+
+func init() {
+	provider.Register("ollama", New)
+}
+
+// Provider adapts llm.OllamaClient to provider.Provider.
+type Provider struct {
+	client   *llm.OllamaClient
+	endpoint string
+	model    string
+}
+
+// New constructs an Ollama provider.Provider from cfg.
+func New(cfg provider.Config) (provider.Provider, error) {
+	return &Provider{
+		client:   llm.NewOllamaClient(),
+		endpoint: cfg.Endpoint,
+		model:    cfg.Model,
+	}, nil
+}
+
+// Name implements provider.Provider.
+func (p *Provider) Name() string { return "ollama" }
+
+// Health implements provider.Provider using the existing health check,
+// which is otherwise wired up as CheckOllamaHealth.
+func (p *Provider) Health(ctx context.Context) error {
+	health, err := p.client.CheckOllamaHealth(ctx, p.endpoint)
+	if err != nil {
+		return err
+	}
+	if !health.IsRunning {
+		return fmt.Errorf("ollama not running at %s: %s", p.endpoint, health.ErrorMessage)
+	}
+	return nil
+}
+
+// ListModels implements provider.Provider.
+func (p *Provider) ListModels(ctx context.Context) ([]string, error) {
+	return p.client.ListModels(ctx, p.endpoint)
+}
+
+// Generate implements provider.Provider.
+func (p *Provider) Generate(ctx context.Context, req provider.GenerateRequest) (provider.GenerateResult, error) {
+	text, err := p.client.CompletePrompt(ctx, p.endpoint, p.model, req.Prompt, req.Temperature)
+	if err != nil {
+		return provider.GenerateResult{}, err
+	}
+	return provider.GenerateResult{Text: text}, nil
+}
+
+// Chat implements provider.Provider.
+func (p *Provider) Chat(ctx context.Context, req provider.ChatRequest) (provider.ChatResult, error) {
+	messages := make([]llm.ChatMessage, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = llm.ChatMessage{Role: m.Role, Content: m.Content}
+	}
+
+	resp, err := p.client.Chat(ctx, p.endpoint, llm.ChatRequest{
+		Model:    p.model,
+		Messages: messages,
+		Options:  map[string]interface{}{"temperature": req.Temperature},
+	})
+	if err != nil {
+		return provider.ChatResult{}, err
+	}
+
+	return provider.ChatResult{
+		Message: provider.Message{Role: resp.Message.Role, Content: resp.Message.Content},
+	}, nil
+}
+
+// Stream implements provider.Provider.
+func (p *Provider) Stream(ctx context.Context, req provider.GenerateRequest) (<-chan provider.StreamChunk, error) {
+	chunks, err := p.client.GenerateStreamRaw(ctx, p.endpoint, llm.OllamaRequest{
+		Model:   p.model,
+		Prompt:  req.Prompt,
+		Options: map[string]interface{}{"temperature": req.Temperature},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan provider.StreamChunk)
+	go func() {
+		defer close(out)
+		for chunk := range chunks {
+			out <- provider.StreamChunk{Text: chunk.Response, Done: chunk.Done, Err: chunk.Err}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,188 @@
+// Package openai implements provider.Provider against any OpenAI-compatible
+// /v1/chat/completions endpoint. This covers hosted OpenAI as well as
+// self-hosted shims such as Ollama's own /v1 endpoint, LM Studio, and vLLM.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm/provider"
+)
+
+func init() {
+	provider.Register("openai", New)
+	provider.Register("openai-compatible", New)
+}
+
+// Provider talks to an OpenAI-compatible chat completions API.
+type Provider struct {
+	httpClient *http.Client
+	endpoint   string
+	apiKey     string
+	model      string
+}
+
+// New constructs an OpenAI-compatible provider.Provider from cfg.
+func New(cfg provider.Config) (provider.Provider, error) {
+	return &Provider{
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		endpoint:   cfg.Endpoint,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+	}, nil
+}
+
+// Name implements provider.Provider.
+func (p *Provider) Name() string { return "openai" }
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Stream      bool          `json:"stream"`
+	Temperature float64       `json:"temperature,omitempty"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Health implements provider.Provider by listing models.
+func (p *Provider) Health(ctx context.Context) error {
+	_, err := p.ListModels(ctx)
+	return err
+}
+
+// ListModels implements provider.Provider.
+func (p *Provider) ListModels(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", p.endpoint+"/models", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	p.authorize(req)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	models := make([]string, len(result.Data))
+	for i, m := range result.Data {
+		models[i] = m.ID
+	}
+	return models, nil
+}
+
+// Generate implements provider.Provider.
+func (p *Provider) Generate(ctx context.Context, req provider.GenerateRequest) (provider.GenerateResult, error) {
+	result, err := p.Chat(ctx, provider.ChatRequest{
+		Messages:    []provider.Message{{Role: "user", Content: req.Prompt}},
+		Temperature: req.Temperature,
+	})
+	if err != nil {
+		return provider.GenerateResult{}, err
+	}
+	return provider.GenerateResult{Text: result.Message.Content}, nil
+}
+
+// Chat implements provider.Provider.
+func (p *Provider) Chat(ctx context.Context, req provider.ChatRequest) (provider.ChatResult, error) {
+	messages := make([]chatMessage, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = chatMessage{Role: m.Role, Content: m.Content}
+	}
+
+	body, err := json.Marshal(chatCompletionRequest{
+		Model:       p.model,
+		Messages:    messages,
+		Temperature: req.Temperature,
+	})
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", p.endpoint+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	p.authorize(httpReq)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return provider.ChatResult{}, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var completion chatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return provider.ChatResult{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if completion.Error != nil {
+		return provider.ChatResult{}, fmt.Errorf("openai error: %s", completion.Error.Message)
+	}
+
+	if len(completion.Choices) == 0 {
+		return provider.ChatResult{}, fmt.Errorf("no choices returned")
+	}
+
+	choice := completion.Choices[0].Message
+	return provider.ChatResult{Message: provider.Message{Role: choice.Role, Content: choice.Content}}, nil
+}
+
+// Stream implements provider.Provider. The OpenAI-compatible path does not
+// yet consume server-sent events, so it emulates streaming with a single
+// terminal chunk.
+func (p *Provider) Stream(ctx context.Context, req provider.GenerateRequest) (<-chan provider.StreamChunk, error) {
+	result, err := p.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan provider.StreamChunk, 1)
+	out <- provider.StreamChunk{Text: result.Text, Done: true}
+	close(out)
+	return out, nil
+}
+
+func (p *Provider) authorize(req *http.Request) {
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+}
@@ -0,0 +1,12 @@
+// Package providers registers every built-in LLM backend with
+// internal/llm/provider's registry. Importing this package for its side
+// effects is enough to make "ollama", "openai", "anthropic", and "gemini"
+// available through provider.Get.
+package providers
+
+import (
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/anthropic"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/gemini"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/ollama"
+	_ "github.com/copyleftdev/faux-foundry/internal/llm/providers/openai"
+)
@@ -0,0 +1,333 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// GenerationChunk represents a single piece of a streaming Ollama response.
+// Chunks arrive as the model produces tokens; the final chunk has Done set
+// and carries the cumulative token counters reported by Ollama.
+type GenerationChunk struct {
+	Response        string
+	Done            bool
+	PromptEvalCount int
+	EvalCount       int
+	EvalDuration    time.Duration
+	Err             error
+}
+
+// PullProgress represents a single progress event emitted while pulling a
+// model from the Ollama registry.
+type PullProgress struct {
+	Status    string
+	Completed int64
+	Total     int64
+	Err       error
+}
+
+// GenerateStreamRaw streams a generation response from Ollama's
+// /api/generate endpoint instead of buffering the whole reply, so callers
+// can show live token throughput and cancel a slow generation via ctx. It
+// yields raw token chunks; GenerateStream builds on it to yield parsed
+// types.Record values instead.
+func (c *OllamaClient) GenerateStreamRaw(ctx context.Context, endpoint string, req OllamaRequest) (<-chan GenerationChunk, error) {
+	req.Stream = true
+
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint+"/api/generate", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	chunks := make(chan GenerationChunk)
+	go c.streamGenerateResponses(ctx, resp.Body, chunks)
+
+	return chunks, nil
+}
+
+// streamGenerateResponses decodes NDJSON generation responses from body and
+// forwards them on chunks until Done is seen, the body is exhausted, an
+// error occurs, or ctx is cancelled.
+func (c *OllamaClient) streamGenerateResponses(ctx context.Context, body io.ReadCloser, chunks chan<- GenerationChunk) {
+	defer body.Close()
+	defer close(chunks)
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var ollamaResp OllamaResponse
+		if err := json.Unmarshal(line, &ollamaResp); err != nil {
+			sendChunk(ctx, chunks, GenerationChunk{Err: fmt.Errorf("failed to decode chunk: %w", err)})
+			return
+		}
+
+		if ollamaResp.Error != "" {
+			sendChunk(ctx, chunks, GenerationChunk{Err: fmt.Errorf("ollama error: %s", ollamaResp.Error)})
+			return
+		}
+
+		chunk := GenerationChunk{
+			Response:        ollamaResp.Response,
+			Done:            ollamaResp.Done,
+			PromptEvalCount: ollamaResp.PromptEvalCount,
+			EvalCount:       ollamaResp.EvalCount,
+			EvalDuration:    time.Duration(ollamaResp.EvalDuration),
+		}
+
+		if !sendChunk(ctx, chunks, chunk) || chunk.Done {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		sendChunk(ctx, chunks, GenerationChunk{Err: fmt.Errorf("stream read error: %w", err)})
+	}
+}
+
+// sendChunk delivers chunk unless ctx is cancelled first, reporting whether
+// the send succeeded.
+func sendChunk(ctx context.Context, chunks chan<- GenerationChunk, chunk GenerationChunk) bool {
+	select {
+	case chunks <- chunk:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// jsonObjectExtractor finds complete top-level `{...}` JSON objects in text
+// fed to it incrementally, a few bytes at a time, the way a model's
+// streamed response arrives. It tracks brace depth plus string/escape state
+// so a brace inside a quoted string value doesn't miscount as structure.
+type jsonObjectExtractor struct {
+	current  bytes.Buffer
+	depth    int
+	inString bool
+	escaped  bool
+}
+
+// feed appends s to the extractor and returns every `{...}` object that
+// closed as a result, in the order they closed. Bytes before the first `{`
+// at depth 0 (explanatory text a model prepends) are discarded.
+func (e *jsonObjectExtractor) feed(s string) []string {
+	var objects []string
+
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+
+		if e.depth == 0 && b != '{' {
+			continue
+		}
+
+		e.current.WriteByte(b)
+
+		if e.escaped {
+			e.escaped = false
+			continue
+		}
+
+		switch {
+		case e.inString:
+			switch b {
+			case '\\':
+				e.escaped = true
+			case '"':
+				e.inString = false
+			}
+		case b == '"':
+			e.inString = true
+		case b == '{':
+			e.depth++
+		case b == '}':
+			e.depth--
+			if e.depth == 0 {
+				objects = append(objects, e.current.String())
+				e.current.Reset()
+			}
+		}
+	}
+
+	return objects
+}
+
+// GenerateStream generates count records against spec, delivering each
+// parsed record as soon as its closing brace arrives in the model's
+// streamed response instead of waiting for the whole completion. The
+// records channel is closed once the stream ends (Done, an error, or ctx
+// cancellation); the error channel carries at most one value and is closed
+// at the same time.
+func (c *OllamaClient) GenerateStream(ctx context.Context, spec *types.Specification, count int) (<-chan types.Record, <-chan error) {
+	records := make(chan types.Record)
+	errs := make(chan error, 1)
+
+	req := OllamaRequest{
+		Model:  spec.Model.Name,
+		Prompt: buildPrompt(spec, count),
+		Options: map[string]interface{}{
+			"temperature": spec.Model.Temperature,
+		},
+		Format: schemaFormat(spec.Dataset.Schema),
+	}
+
+	chunks, err := c.GenerateStreamRaw(ctx, spec.Model.Endpoint, req)
+	if err != nil {
+		errs <- err
+		close(records)
+		close(errs)
+		return records, errs
+	}
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		extractor := &jsonObjectExtractor{}
+		for chunk := range chunks {
+			if chunk.Err != nil {
+				errs <- chunk.Err
+				return
+			}
+
+			for _, raw := range extractor.feed(chunk.Response) {
+				var record types.Record
+				if err := json.Unmarshal([]byte(raw), &record); err != nil {
+					fixed := fixCommonJSONIssues(raw)
+					if err := json.Unmarshal([]byte(fixed), &record); err != nil {
+						c.Logger.Debug("skipping unparseable streamed object", "error", err)
+						continue
+					}
+				}
+
+				if !validateRecord(record, spec) {
+					continue
+				}
+
+				select {
+				case records <- record:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return records, errs
+}
+
+// PullModelStream pulls a model from the Ollama registry, streaming the
+// {"status","completed","total"} progress events Ollama reports so callers
+// can render a real download progress bar.
+func (c *OllamaClient) PullModelStream(ctx context.Context, endpoint, modelName string) (<-chan PullProgress, error) {
+	pullReq := map[string]interface{}{"name": modelName}
+
+	jsonData, err := json.Marshal(pullReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pull request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint+"/api/pull", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull model: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("pull failed with status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	progress := make(chan PullProgress)
+	go c.streamPullProgress(ctx, resp.Body, progress)
+
+	return progress, nil
+}
+
+// streamPullProgress decodes NDJSON pull events from body and forwards them
+// on progress until the body is exhausted, an error occurs, or ctx is
+// cancelled.
+func (c *OllamaClient) streamPullProgress(ctx context.Context, body io.ReadCloser, progress chan<- PullProgress) {
+	defer body.Close()
+	defer close(progress)
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var event struct {
+			Status    string `json:"status"`
+			Completed int64  `json:"completed"`
+			Total     int64  `json:"total"`
+			Error     string `json:"error"`
+		}
+		if err := json.Unmarshal(line, &event); err != nil {
+			sendPullProgress(ctx, progress, PullProgress{Err: fmt.Errorf("failed to decode pull event: %w", err)})
+			return
+		}
+
+		if event.Error != "" {
+			sendPullProgress(ctx, progress, PullProgress{Err: fmt.Errorf("ollama error: %s", event.Error)})
+			return
+		}
+
+		if !sendPullProgress(ctx, progress, PullProgress{Status: event.Status, Completed: event.Completed, Total: event.Total}) {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		sendPullProgress(ctx, progress, PullProgress{Err: fmt.Errorf("stream read error: %w", err)})
+	}
+}
+
+// sendPullProgress delivers event unless ctx is cancelled first, reporting
+// whether the send succeeded.
+func sendPullProgress(ctx context.Context, progress chan<- PullProgress, event PullProgress) bool {
+	select {
+	case progress <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
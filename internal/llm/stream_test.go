@@ -0,0 +1,70 @@
+package llm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJSONObjectExtractorFindsMultipleObjects(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	objects := e.feed(`{"a":1}{"b":2}`)
+	if !reflect.DeepEqual(objects, []string{`{"a":1}`, `{"b":2}`}) {
+		t.Fatalf("feed() = %v, want two back-to-back objects", objects)
+	}
+}
+
+func TestJSONObjectExtractorIgnoresBracesInsideStrings(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	objects := e.feed(`{"note": "a { b } c"}`)
+	if len(objects) != 1 {
+		t.Fatalf("feed() returned %d objects, want 1", len(objects))
+	}
+	if objects[0] != `{"note": "a { b } c"}` {
+		t.Errorf("feed() = %q, want the full object untouched by the embedded braces", objects[0])
+	}
+}
+
+func TestJSONObjectExtractorIgnoresEscapedQuotes(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	objects := e.feed(`{"note": "she said \"hi {there}\""}`)
+	if len(objects) != 1 {
+		t.Fatalf("feed() returned %d objects, want 1", len(objects))
+	}
+}
+
+func TestJSONObjectExtractorDiscardsLeadingText(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	objects := e.feed(`Sure, here is the data: {"a":1}`)
+	if !reflect.DeepEqual(objects, []string{`{"a":1}`}) {
+		t.Fatalf("feed() = %v, want the leading prose discarded", objects)
+	}
+}
+
+func TestJSONObjectExtractorHandlesIncrementalFeed(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	var got []string
+	for _, chunk := range []string{`{"a"`, `:1, "b"`, `:2}`, `{"c":3}`} {
+		got = append(got, e.feed(chunk)...)
+	}
+
+	if !reflect.DeepEqual(got, []string{`{"a":1, "b":2}`, `{"c":3}`}) {
+		t.Fatalf("incremental feed() = %v, want objects to close across chunk boundaries", got)
+	}
+}
+
+func TestJSONObjectExtractorLeavesUnclosedObjectBuffered(t *testing.T) {
+	e := &jsonObjectExtractor{}
+
+	if objects := e.feed(`{"a":1`); len(objects) != 0 {
+		t.Fatalf("feed() on an unclosed object = %v, want none yet", objects)
+	}
+	objects := e.feed(`}`)
+	if !reflect.DeepEqual(objects, []string{`{"a":1}`}) {
+		t.Fatalf("feed() after closing brace = %v, want the completed object", objects)
+	}
+}
@@ -6,6 +6,11 @@ import (
 	"math"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/metrics"
 	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
@@ -17,16 +22,31 @@ const (
 	StrategyReduce
 	StrategySimplify
 	StrategyFallback
+	// StrategyAbort means the error is permanent (see PermanentError):
+	// determineStrategy has decided no amount of retrying, reducing, or
+	// simplifying will help, so GenerateWithRetry gives up immediately
+	// without falling back either.
+	StrategyAbort
 )
 
 // RetryConfig defines retry behavior for timeout handling
 type RetryConfig struct {
-	MaxRetries       int           // Maximum number of retries
-	BaseTimeout      time.Duration // Initial timeout duration
-	MaxTimeout       time.Duration // Maximum timeout duration
-	BackoffMultiplier float64      // Exponential backoff multiplier
-	ReduceFactorOnTimeout float64  // Factor to reduce batch size on timeout
-	MinBatchSize     int           // Minimum batch size before giving up
+	MaxRetries            int           // Maximum number of retries
+	BaseTimeout           time.Duration // Initial timeout duration
+	MaxTimeout            time.Duration // Maximum timeout duration
+	BackoffMultiplier     float64       // Exponential backoff multiplier
+	ReduceFactorOnTimeout float64       // Factor to reduce batch size on timeout
+	MinBatchSize          int           // Minimum batch size before giving up
+
+	// RandomizationFactor jitters each backoff interval into a uniform
+	// draw from [interval*(1-r), interval*(1+r)], so many workers hitting
+	// the same failing endpoint don't all retry in lockstep. 0 disables
+	// jitter; the default is 0.5, matching cenkalti/backoff.
+	RandomizationFactor float64
+	// MaxElapsedTime bounds the wall-clock time GenerateWithRetry spends
+	// retrying a single GenerateWithRetry call, regardless of MaxRetries.
+	// Zero means no ceiling.
+	MaxElapsedTime time.Duration
 }
 
 // DefaultRetryConfig returns sensible defaults for retry configuration
@@ -38,6 +58,8 @@ func DefaultRetryConfig() *RetryConfig {
 		BackoffMultiplier:     2.0,
 		ReduceFactorOnTimeout: 0.5,
 		MinBatchSize:          1,
+		RandomizationFactor:   0.5,
+		MaxElapsedTime:        15 * time.Minute,
 	}
 }
 
@@ -45,6 +67,23 @@ func DefaultRetryConfig() *RetryConfig {
 type TimeoutHandler struct {
 	config *RetryConfig
 	client *OllamaClient
+
+	// Backoff schedules the wait between retries. NewTimeoutHandler
+	// defaults it to an ExponentialBackoff built from config, but it can
+	// be swapped for ConstantBackoff or a custom Backoff implementation
+	// (e.g. in tests, to retry without actually sleeping).
+	Backoff Backoff
+
+	// Metrics records Prometheus counters/histograms for every batch this
+	// handler generates. NewTimeoutHandler copies it from client.Metrics;
+	// nil disables instrumentation entirely.
+	Metrics *metrics.Registry
+
+	// Logger receives every structured event GenerateWithRetry emits
+	// (batch attempts, strategy transitions, fallbacks, and the final
+	// result). NewTimeoutHandler copies it from client.Logger, which is
+	// never nil.
+	Logger log.Logger
 }
 
 // NewTimeoutHandler creates a new timeout handler
@@ -53,8 +92,17 @@ func NewTimeoutHandler(client *OllamaClient, config *RetryConfig) *TimeoutHandle
 		config = DefaultRetryConfig()
 	}
 	return &TimeoutHandler{
-		config: config,
-		client: client,
+		config:  config,
+		client:  client,
+		Metrics: client.Metrics,
+		Logger:  client.Logger,
+		Backoff: &ExponentialBackoff{
+			InitialInterval:     config.BaseTimeout,
+			MaxInterval:         config.MaxTimeout,
+			Multiplier:          config.BackoffMultiplier,
+			RandomizationFactor: config.RandomizationFactor,
+			MaxElapsedTime:      config.MaxElapsedTime,
+		},
 	}
 }
 
@@ -65,40 +113,77 @@ func (th *TimeoutHandler) GenerateWithRetry(ctx context.Context, spec *types.Spe
 	currentBatchSize := spec.Model.BatchSize
 	attempt := 0
 	currentTimeout := th.config.BaseTimeout
+	startTime := time.Now()
+
+	if th.Backoff != nil {
+		th.Backoff.Reset()
+	}
+
+	th.Logger.Info("starting generation with timeout handling", "target_records", count)
 
-	fmt.Printf("🔄 Starting generation with timeout handling (target: %d records)\n", count)
+	lastStrategy := "direct"
 
 	for remaining > 0 && attempt < th.config.MaxRetries {
+		if th.config.MaxElapsedTime > 0 && time.Since(startTime) > th.config.MaxElapsedTime {
+			break
+		}
+
 		batchSize := min(currentBatchSize, remaining)
 		attempt++
 
-		fmt.Printf("📦 Attempt %d: Generating %d records (timeout: %s)\n", 
-			attempt, batchSize, currentTimeout)
+		th.Logger.Info("batch attempt", "attempt", attempt, "batch_size", batchSize, "timeout", currentTimeout.String())
+
+		batchCtx, span := metrics.Tracer.Start(ctx, "llm.generate_batch")
+		span.SetAttributes(
+			attribute.Int("attempt", attempt),
+			attribute.String("timeout", currentTimeout.String()),
+			attribute.Int("batch_size", batchSize),
+		)
+		batchCtx, cancel := context.WithTimeout(batchCtx, currentTimeout)
 
-		// Create context with current timeout
-		batchCtx, cancel := context.WithTimeout(ctx, currentTimeout)
-		
 		// Try to generate the batch
+		batchStart := time.Now()
 		records, err := th.generateBatch(batchCtx, spec, batchSize)
 		cancel()
+		th.Metrics.ObserveBatchDuration(spec.Model.Name, time.Since(batchStart))
 
 		if err != nil {
 			strategy := th.determineStrategy(err, attempt, currentBatchSize)
-			fmt.Printf("⚠️  Batch failed: %v\n", err)
-			fmt.Printf("🔧 Applying strategy: %s\n", th.strategyName(strategy))
+			strategyLabel := strategyMetricLabel(strategy)
+			span.SetAttributes(attribute.String("strategy", strategyLabel))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			th.Metrics.RecordBatchAttempt(strategyLabel, "failure")
+			th.Metrics.RecordStrategyTransition(lastStrategy, strategyLabel)
+			lastStrategy = strategyLabel
+			th.Logger.Warn("batch failed", "error", err, "strategy", strategyLabel, "strategy_name", th.strategyName(strategy))
 
 			switch strategy {
+			case StrategyAbort:
+				// The error is permanent: no retry, no fallback.
+				th.Logger.Error("permanent error, giving up", "error", err)
+				span.End()
+				return allRecords, fmt.Errorf("permanent error: %w", err)
+
 			case StrategyRetry:
 				// Increase timeout and retry same batch size
 				currentTimeout = th.increaseTimeout(currentTimeout)
-				fmt.Printf("⏱️  Increased timeout to %s, retrying...\n", currentTimeout)
+				th.Logger.Info("retrying with increased timeout", "timeout", currentTimeout.String())
+				span.End()
+				if sleepErr := th.sleep(ctx); sleepErr != nil {
+					return allRecords, sleepErr
+				}
 				continue
 
 			case StrategyReduce:
 				// Reduce batch size and retry
 				currentBatchSize = max(1, int(float64(currentBatchSize)*th.config.ReduceFactorOnTimeout))
 				currentTimeout = th.config.BaseTimeout // Reset timeout
-				fmt.Printf("📉 Reduced batch size to %d, resetting timeout\n", currentBatchSize)
+				th.Logger.Info("reduced batch size, resetting timeout", "batch_size", currentBatchSize)
+				span.End()
+				if sleepErr := th.sleep(ctx); sleepErr != nil {
+					return allRecords, sleepErr
+				}
 				continue
 
 			case StrategySimplify:
@@ -106,26 +191,38 @@ func (th *TimeoutHandler) GenerateWithRetry(ctx context.Context, spec *types.Spe
 				simplifiedSpec := th.simplifySpec(spec)
 				records, err = th.generateBatch(batchCtx, simplifiedSpec, batchSize)
 				if err != nil {
-					fmt.Printf("❌ Simplified generation also failed: %v\n", err)
+					th.Logger.Error("simplified generation also failed", "error", err)
+					span.End()
 					continue
 				}
 
 			case StrategyFallback:
 				// Use fallback generation method
-				fmt.Printf("🆘 Using fallback generation method\n")
+				th.Logger.Warn("using fallback generation method")
 				records = th.generateFallbackData(spec, batchSize)
+				th.Metrics.RecordFallbackRecords(len(records))
 			}
+		} else {
+			th.Metrics.RecordBatchAttempt(lastStrategy, "success")
 		}
 
+		span.End()
+
 		// Successfully generated records
 		if len(records) > 0 {
 			allRecords = append(allRecords, records...)
 			remaining -= len(records)
-			fmt.Printf("✅ Generated %d records (%d remaining)\n", len(records), remaining)
-			
+			// TimeoutHandler only ever sees the Specification itself, not
+			// a caller-assigned spec name/file, so the "spec" label falls
+			// back to the dataset domain too until a caller threads a
+			// distinct identifier through.
+			th.Metrics.RecordRecordsGenerated(spec.Dataset.Domain, spec.Dataset.Domain, len(records))
+			th.Logger.Info("generated records", "count", len(records), "remaining", remaining)
+
 			// Reset for next batch
 			attempt = 0
 			currentTimeout = th.config.BaseTimeout
+			lastStrategy = "direct"
 		}
 	}
 
@@ -134,7 +231,7 @@ func (th *TimeoutHandler) GenerateWithRetry(ctx context.Context, spec *types.Spe
 	}
 
 	if len(allRecords) < count {
-		fmt.Printf("⚠️  Generated %d/%d records (partial success)\n", len(allRecords), count)
+		th.Logger.Warn("partial success", "generated", len(allRecords), "target", count)
 	}
 
 	return allRecords, nil
@@ -146,8 +243,36 @@ func (th *TimeoutHandler) generateBatch(ctx context.Context, spec *types.Specifi
 	return th.client.GenerateBasic(ctx, spec, count)
 }
 
+// sleep waits for the next interval th.Backoff schedules, returning early
+// with ctx.Err() if ctx is cancelled first. A BackoffStop interval (the
+// schedule's MaxElapsedTime budget is exhausted) returns a "retry budget
+// exhausted" error without waiting, which ends GenerateWithRetry's
+// retries. A nil Backoff skips the wait entirely; that only happens if a
+// caller set th.Backoff to nil after construction.
+func (th *TimeoutHandler) sleep(ctx context.Context) error {
+	if th.Backoff == nil {
+		return nil
+	}
+
+	wait := th.Backoff.NextBackoff()
+	if wait == BackoffStop {
+		return fmt.Errorf("retry budget exhausted")
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
 // determineStrategy decides which strategy to use based on the error and context
 func (th *TimeoutHandler) determineStrategy(err error, attempt int, currentBatchSize int) TimeoutStrategy {
+	if IsPermanent(err) {
+		return StrategyAbort
+	}
+
 	errStr := err.Error()
 
 	// Check if it's a timeout error
@@ -259,8 +384,8 @@ func (th *TimeoutHandler) simplifySpec(spec *types.Specification) *types.Specifi
 
 // generateFallbackData generates basic fallback data when all else fails
 func (th *TimeoutHandler) generateFallbackData(spec *types.Specification, count int) []types.Record {
-	fmt.Printf("🔧 Generating fallback data (basic patterns)\n")
-	
+	th.Logger.Info("generating fallback data", "count", count)
+
 	records := make([]types.Record, count)
 	for i := 0; i < count; i++ {
 		record := make(types.Record)
@@ -269,7 +394,7 @@ func (th *TimeoutHandler) generateFallbackData(spec *types.Specification, count
 			switch field.Type {
 			case "string":
 				if field.Pattern != "" {
-					record[field.Name] = th.client.generatePatternString(field.Pattern, i)
+					record[field.Name] = generatePatternString(field.Pattern, i)
 				} else {
 					record[field.Name] = fmt.Sprintf("fallback_%s_%d", field.Name, i)
 				}
@@ -319,11 +444,34 @@ func (th *TimeoutHandler) strategyName(strategy TimeoutStrategy) string {
 		return "Simplify specification"
 	case StrategyFallback:
 		return "Use fallback generation"
+	case StrategyAbort:
+		return "Abort (permanent error)"
 	default:
 		return "Unknown strategy"
 	}
 }
 
+// strategyMetricLabel returns the short, stable label strategy contributes
+// to faux_foundry_batch_attempts_total and
+// faux_foundry_timeout_strategy_transitions_total — strategyName's text is
+// meant for a human reading CLI output, not a Prometheus label value.
+func strategyMetricLabel(strategy TimeoutStrategy) string {
+	switch strategy {
+	case StrategyRetry:
+		return "retry"
+	case StrategyReduce:
+		return "reduce"
+	case StrategySimplify:
+		return "simplify"
+	case StrategyFallback:
+		return "fallback"
+	case StrategyAbort:
+		return "abort"
+	default:
+		return "unknown"
+	}
+}
+
 // max returns the maximum of two integers
 func max(a, b int) int {
 	if a > b {
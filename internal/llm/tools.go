@@ -0,0 +1,267 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// emitRecordToolName is the name of the tool the model is forced to call
+// once per generated record.
+const emitRecordToolName = "emit_record"
+
+// Tool describes a function the model may call, matching Ollama's tool schema.
+type Tool struct {
+	Type     string       `json:"type"`
+	Function ToolFunction `json:"function"`
+}
+
+// ToolFunction describes a single callable function and its JSON-Schema parameters.
+type ToolFunction struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters"`
+}
+
+// ToolCall represents a model-issued request to invoke a tool.
+type ToolCall struct {
+	Function ToolCallFunction `json:"function"`
+}
+
+// ToolCallFunction carries the name and raw JSON arguments of a tool call.
+type ToolCallFunction struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// ChatMessage represents a single message in an Ollama /api/chat conversation.
+type ChatMessage struct {
+	Role      string     `json:"role"`
+	Content   string     `json:"content,omitempty"`
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatRequest represents a request to Ollama's /api/chat endpoint.
+type ChatRequest struct {
+	Model    string                 `json:"model"`
+	Messages []ChatMessage          `json:"messages"`
+	Tools    []Tool                 `json:"tools,omitempty"`
+	Stream   bool                   `json:"stream"`
+	Options  map[string]interface{} `json:"options,omitempty"`
+}
+
+// ChatResponse represents a response from Ollama's /api/chat endpoint.
+type ChatResponse struct {
+	Model   string      `json:"model"`
+	Message ChatMessage `json:"message"`
+	Done    bool        `json:"done"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// modelsWithoutToolSupport lists models known not to support Ollama's
+// tool-calling API, so GenerateWithTools can skip straight to the free-text
+// JSON path instead of paying for a failed round-trip.
+var modelsWithoutToolSupport = map[string]bool{}
+
+// SupportsTools reports whether modelName is expected to support Ollama's
+// tool-calling API. Models are assumed to support tools unless explicitly
+// listed otherwise.
+func (c *OllamaClient) SupportsTools(modelName string) bool {
+	return !modelsWithoutToolSupport[modelName]
+}
+
+// BuildEmitRecordTool compiles a specification's dataset fields into a
+// single emit_record tool whose JSON-Schema parameters mirror the fields, so
+// the model can be forced to call it once per record instead of relying on
+// free-text JSON prompting.
+func BuildEmitRecordTool(spec *types.Specification) Tool {
+	properties := make(map[string]interface{}, len(spec.Dataset.Fields))
+	required := make([]string, 0, len(spec.Dataset.Fields))
+
+	for _, field := range spec.Dataset.Fields {
+		properties[field.Name] = fieldJSONSchema(field)
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	parameters, _ := json.Marshal(schema)
+
+	return Tool{
+		Type: "function",
+		Function: ToolFunction{
+			Name:        emitRecordToolName,
+			Description: fmt.Sprintf("Emit a single generated record for %s", spec.Dataset.Domain),
+			Parameters:  parameters,
+		},
+	}
+}
+
+// fieldJSONSchema converts a spec field into its JSON-Schema representation.
+func fieldJSONSchema(field types.Field) map[string]interface{} {
+	schema := map[string]interface{}{}
+
+	switch field.Type {
+	case "integer":
+		schema["type"] = "integer"
+	case "float":
+		schema["type"] = "number"
+	case "boolean":
+		schema["type"] = "boolean"
+	case "array":
+		schema["type"] = "array"
+	case "object":
+		schema["type"] = "object"
+	default:
+		schema["type"] = "string"
+	}
+
+	if field.Description != "" {
+		schema["description"] = field.Description
+	}
+	if field.Pattern != "" {
+		schema["pattern"] = field.Pattern
+	}
+	if len(field.Values) > 0 {
+		values := make([]string, len(field.Values))
+		copy(values, field.Values)
+		schema["enum"] = values
+	}
+	if len(field.Range) == 2 {
+		schema["minimum"] = field.Range[0]
+		schema["maximum"] = field.Range[1]
+	}
+
+	return schema
+}
+
+// GenerateWithTools generates records by forcing the model to call the
+// emit_record tool once per record, giving the deduplicator
+// (dedup.Deduplicator.canonicalHash) strictly-typed input and eliminating
+// prompt-parsing failures. It falls back to GenerateBasic's free-text JSON
+// path when the model reports no tool support or stops calling the tool.
+func (c *OllamaClient) GenerateWithTools(ctx context.Context, spec *types.Specification, count int) ([]types.Record, error) {
+	if !c.SupportsTools(spec.Model.Name) {
+		return c.GenerateBasic(ctx, spec, count)
+	}
+
+	tool := BuildEmitRecordTool(spec)
+	records := make([]types.Record, 0, count)
+
+	for len(records) < count {
+		req := ChatRequest{
+			Model: spec.Model.Name,
+			Messages: []ChatMessage{
+				{
+					Role: "system",
+					Content: fmt.Sprintf(
+						"You generate realistic synthetic data for %s. Call %s exactly once per record.",
+						spec.Dataset.Domain, emitRecordToolName,
+					),
+				},
+				{Role: "user", Content: fmt.Sprintf("Generate record %d of %d.", len(records)+1, count)},
+			},
+			Tools: []Tool{tool},
+			Options: map[string]interface{}{
+				"temperature": spec.Model.Temperature,
+			},
+		}
+
+		resp, err := c.chat(ctx, spec.Model.Endpoint+"/api/chat", req)
+		if err != nil {
+			if !strings.Contains(err.Error(), "does not support tools") {
+				return records, fmt.Errorf("failed to generate record %d: %w", len(records)+1, err)
+			}
+			// Ollama rejects the request when the model has no tool
+			// support; an empty response sends it down the fallback below.
+			resp = &ChatResponse{}
+		}
+
+		record, ok := recordFromToolCalls(resp.Message.ToolCalls, emitRecordToolName)
+		if !ok {
+			// The model stopped calling the tool; finish the batch with the
+			// free-text path rather than failing outright.
+			fallback, err := c.GenerateBasic(ctx, spec, count-len(records))
+			if err != nil {
+				return records, err
+			}
+			return append(records, fallback...), nil
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// recordFromToolCalls extracts the first call to toolName and decodes its
+// arguments into a Record.
+func recordFromToolCalls(calls []ToolCall, toolName string) (types.Record, bool) {
+	for _, call := range calls {
+		if call.Function.Name != toolName {
+			continue
+		}
+		var record types.Record
+		if err := json.Unmarshal(call.Function.Arguments, &record); err != nil {
+			return nil, false
+		}
+		return record, true
+	}
+	return nil, false
+}
+
+// Chat sends a chat request to Ollama's /api/chat endpoint. It is the
+// low-level primitive the ollama provider.Provider adapter builds on.
+func (c *OllamaClient) Chat(ctx context.Context, endpoint string, req ChatRequest) (*ChatResponse, error) {
+	return c.chat(ctx, endpoint+"/api/chat", req)
+}
+
+// chat makes a non-streaming request to Ollama's /api/chat endpoint.
+func (c *OllamaClient) chat(ctx context.Context, url string, req ChatRequest) (*ChatResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	var chatResp ChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if chatResp.Error != "" {
+		return nil, fmt.Errorf("ollama error: %s", chatResp.Error)
+	}
+
+	return &chatResp, nil
+}
@@ -0,0 +1,90 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Codec encodes a types.Record into the wire format a Publisher sends, and
+// reports the content type MessageWriter stamps onto every message's
+// attributes so a downstream consumer can decode it without out-of-band
+// coordination.
+type Codec interface {
+	Encode(record types.Record) ([]byte, error)
+	ContentType() string
+}
+
+// JSONCodec encodes records the same way JSONLWriter does, so a consumer
+// reading a sink and a consumer reading a JSONL file parse identically.
+type JSONCodec struct{}
+
+// Encode marshals record as JSON.
+func (JSONCodec) Encode(record types.Record) ([]byte, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode record as JSON: %w", err)
+	}
+	return data, nil
+}
+
+// ContentType returns "application/json".
+func (JSONCodec) ContentType() string { return "application/json" }
+
+// MsgpackCodec encodes records as MessagePack, a more compact binary
+// alternative to JSON for high-throughput sinks.
+type MsgpackCodec struct{}
+
+// Encode marshals record as MessagePack.
+func (MsgpackCodec) Encode(record types.Record) ([]byte, error) {
+	data, err := msgpack.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode record as msgpack: %w", err)
+	}
+	return data, nil
+}
+
+// ContentType returns "application/msgpack".
+func (MsgpackCodec) ContentType() string { return "application/msgpack" }
+
+// ProtobufCodec encodes records as a google.protobuf.Struct. A types.Record
+// shape comes from a user's YAML spec rather than a generated .proto
+// message, so Struct is the standard way to carry an arbitrary JSON-like
+// value over protobuf without one.
+type ProtobufCodec struct{}
+
+// Encode converts record to a structpb.Struct and marshals it as protobuf.
+func (ProtobufCodec) Encode(record types.Record) ([]byte, error) {
+	s, err := structpb.NewStruct(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert record to protobuf struct: %w", err)
+	}
+	data, err := proto.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode record as protobuf: %w", err)
+	}
+	return data, nil
+}
+
+// ContentType returns "application/protobuf".
+func (ProtobufCodec) ContentType() string { return "application/protobuf" }
+
+// CodecFor resolves a --sink-codec flag value ("json", "msgpack",
+// "protobuf") to a Codec, defaulting to JSONCodec for an empty name.
+func CodecFor(name string) (Codec, error) {
+	switch name {
+	case "", "json":
+		return JSONCodec{}, nil
+	case "msgpack":
+		return MsgpackCodec{}, nil
+	case "protobuf":
+		return ProtobufCodec{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sink codec: %q", name)
+	}
+}
@@ -0,0 +1,74 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// kafkaPublisher publishes messages to a single Kafka topic via
+// segmentio/kafka-go. It implements both Publisher and BatchPublisher:
+// PublishBatch hands every message to a single kafka.Writer.WriteMessages
+// call, which produces them in one request instead of one per message.
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// newKafkaPublisher builds a kafkaPublisher from a "kafka://broker/topic"
+// URI (a comma-separated host list is accepted in the host position, e.g.
+// "kafka://broker1:9092,broker2:9092/topic"). It's registered under the
+// "kafka" scheme in sinkFactories.
+func newKafkaPublisher(uri *url.URL) (Publisher, error) {
+	topic := strings.TrimPrefix(uri.Path, "/")
+	if topic == "" {
+		return nil, fmt.Errorf("kafka sink URI %s is missing a topic path, e.g. kafka://broker:9092/topic", uri)
+	}
+	if uri.Host == "" {
+		return nil, fmt.Errorf("kafka sink URI %s is missing a broker host", uri)
+	}
+
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(strings.Split(uri.Host, ",")...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireOne,
+		},
+	}, nil
+}
+
+// Publish produces a single message.
+func (k *kafkaPublisher) Publish(ctx context.Context, key string, value []byte, attrs map[string]string) error {
+	return k.writer.WriteMessages(ctx, kafka.Message{Key: []byte(key), Value: value, Headers: toKafkaHeaders(attrs)})
+}
+
+// PublishBatch produces every message in msgs as a single WriteMessages
+// call, i.e. one Kafka produce request for the whole batch.
+func (k *kafkaPublisher) PublishBatch(ctx context.Context, msgs []PublishMessage) error {
+	kafkaMsgs := make([]kafka.Message, len(msgs))
+	for i, msg := range msgs {
+		kafkaMsgs[i] = kafka.Message{Key: []byte(msg.Key), Value: msg.Value, Headers: toKafkaHeaders(msg.Attrs)}
+	}
+	return k.writer.WriteMessages(ctx, kafkaMsgs...)
+}
+
+// Close flushes and closes the underlying kafka.Writer.
+func (k *kafkaPublisher) Close() error {
+	return k.writer.Close()
+}
+
+// toKafkaHeaders converts a PublishMessage's string-keyed attributes into
+// kafka-go's Header slice.
+func toKafkaHeaders(attrs map[string]string) []kafka.Header {
+	if len(attrs) == 0 {
+		return nil
+	}
+	headers := make([]kafka.Header, 0, len(attrs))
+	for k, v := range attrs {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	return headers
+}
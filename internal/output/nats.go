@@ -0,0 +1,79 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// natsPublisher publishes messages to a single NATS JetStream subject. It
+// implements Publisher only — JetStream has no multi-message produce call,
+// so MessageWriter.WriteBatch falls back to one Publish per record for
+// this adapter.
+type natsPublisher struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+// newNATSPublisher builds a natsPublisher from a "nats://host:4222/subject"
+// URI, defaulting to nats.DefaultURL's host when none is given. It's
+// registered under the "nats" scheme in sinkFactories.
+func newNATSPublisher(uri *url.URL) (Publisher, error) {
+	subject := strings.TrimPrefix(uri.Path, "/")
+	if subject == "" {
+		return nil, fmt.Errorf("nats sink URI %s is missing a subject path, e.g. nats://host:4222/subject", uri)
+	}
+
+	addr := uri.Host
+	if addr == "" {
+		addr = nats.DefaultURL
+	} else {
+		addr = "nats://" + addr
+	}
+
+	conn, err := nats.Connect(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS at %s: %w", addr, err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream context: %w", err)
+	}
+
+	return &natsPublisher{conn: conn, js: js, subject: subject}, nil
+}
+
+// Publish sends a single JetStream message, carrying attrs as NATS message
+// headers.
+func (n *natsPublisher) Publish(ctx context.Context, key string, value []byte, attrs map[string]string) error {
+	msg := &nats.Msg{Subject: n.subject, Data: value, Header: toNATSHeader(attrs)}
+	if key != "" {
+		// Nats-Msg-Id is JetStream's de-duplication header: redelivering
+		// the same key within the stream's dedup window is a no-op.
+		msg.Header.Set("Nats-Msg-Id", key)
+	}
+	_, err := n.js.PublishMsg(ctx, msg)
+	return err
+}
+
+// Close drains in-flight publishes and closes the connection.
+func (n *natsPublisher) Close() error {
+	return n.conn.Drain()
+}
+
+// toNATSHeader converts a PublishMessage's string-keyed attributes into a
+// nats.Header.
+func toNATSHeader(attrs map[string]string) nats.Header {
+	header := make(nats.Header, len(attrs))
+	for k, v := range attrs {
+		header.Set(k, v)
+	}
+	return header
+}
@@ -0,0 +1,202 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// PublishMessage is a single message handed to a Publisher: the encoded
+// record plus the key and attributes (headers) a consumer uses for
+// partitioning and routing.
+type PublishMessage struct {
+	Key   string
+	Value []byte
+	Attrs map[string]string
+}
+
+// Publisher is the adapter interface a message-bus client implements to
+// back a MessageWriter. kafkaPublisher and natsPublisher are the built-in
+// adapters; RegisterSink lets a caller plug in anything else (Redis
+// Streams, SQS, an in-memory fake for tests) behind a --sink URI scheme of
+// its own.
+type Publisher interface {
+	// Publish sends a single message.
+	Publish(ctx context.Context, key string, value []byte, attrs map[string]string) error
+	// Close releases the underlying connection.
+	Close() error
+}
+
+// BatchPublisher is implemented by a Publisher that can send several
+// messages as one underlying request (e.g. Kafka's multi-message produce
+// call) instead of one round trip per message. MessageWriter.WriteBatch
+// uses it when available.
+type BatchPublisher interface {
+	PublishBatch(ctx context.Context, msgs []PublishMessage) error
+}
+
+// SinkFactory builds a Publisher from a parsed --sink URI, e.g.
+// kafka://broker:9092/topic or nats://host:4222/subject.
+type SinkFactory func(uri *url.URL) (Publisher, error)
+
+// sinkFactories maps a --sink URI scheme to the factory that builds its
+// Publisher. Populated with the built-in Kafka and NATS adapters;
+// RegisterSink adds more.
+var sinkFactories = map[string]SinkFactory{
+	"kafka": newKafkaPublisher,
+	"nats":  newNATSPublisher,
+}
+
+// RegisterSink adds (or overrides) the Publisher factory for a --sink URI
+// scheme. Call it from an init() before OpenSink runs, so a caller can
+// teach the generate command about a message bus FauxFoundry doesn't ship
+// an adapter for.
+func RegisterSink(scheme string, factory SinkFactory) {
+	sinkFactories[scheme] = factory
+}
+
+// MessageWriter is a Writer that publishes each record to a Publisher
+// instead of writing it to a file, so generated records can be piped
+// straight into a downstream load test or replay consumer.
+type MessageWriter struct {
+	ctx   context.Context
+	pub   Publisher
+	codec Codec
+	uri   string
+
+	baseAttrs  map[string]string
+	batchAttrs map[string]string
+
+	recordCount int
+}
+
+// NewMessageWriter dispatches uri's scheme (via sinkFactories) to build a
+// Publisher, and wraps it in a MessageWriter that encodes records with
+// codec and stamps baseAttrs (e.g. spec domain, model name) onto every
+// message, merged with whatever SetBatchAttrs most recently set.
+func NewMessageWriter(ctx context.Context, uri string, codec Codec, baseAttrs map[string]string) (*MessageWriter, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sink URI: %w", err)
+	}
+
+	factory, ok := sinkFactories[parsed.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sink scheme %q (want one of kafka, nats, or a scheme registered via RegisterSink)", parsed.Scheme)
+	}
+
+	pub, err := factory(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sink %s: %w", uri, err)
+	}
+
+	return &MessageWriter{
+		ctx:       ctx,
+		pub:       pub,
+		codec:     codec,
+		uri:       uri,
+		baseAttrs: baseAttrs,
+	}, nil
+}
+
+// SetBatchAttrs replaces the per-batch attributes (e.g. batch id, generated
+// timestamp) merged into every message's headers until the next call.
+// Callers that don't need per-batch attributes can skip it.
+func (m *MessageWriter) SetBatchAttrs(attrs map[string]string) {
+	m.batchAttrs = attrs
+}
+
+// mergedAttrs combines baseAttrs, the current batchAttrs, and the codec's
+// content type into the attribute map a single Publish/PublishBatch call
+// gets.
+func (m *MessageWriter) mergedAttrs() map[string]string {
+	merged := make(map[string]string, len(m.baseAttrs)+len(m.batchAttrs)+1)
+	for k, v := range m.baseAttrs {
+		merged[k] = v
+	}
+	for k, v := range m.batchAttrs {
+		merged[k] = v
+	}
+	merged["content-type"] = m.codec.ContentType()
+	return merged
+}
+
+// Write encodes record with m.codec and publishes it as a single message.
+func (m *MessageWriter) Write(record types.Record) error {
+	value, err := m.codec.Encode(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	if err := m.pub.Publish(m.ctx, "", value, m.mergedAttrs()); err != nil {
+		return fmt.Errorf("failed to publish record: %w", err)
+	}
+	m.recordCount++
+	return nil
+}
+
+// WriteBatch encodes every record and publishes them as a single
+// PublishBatch call when m.pub is a BatchPublisher, falling back to one
+// Publish call per record otherwise. StreamingWriter.Flush prefers this
+// over looping Write, so a buffered batch becomes one produce request
+// instead of bufferSize of them.
+func (m *MessageWriter) WriteBatch(records []types.Record) error {
+	batch, ok := m.pub.(BatchPublisher)
+	if !ok {
+		for _, record := range records {
+			if err := m.Write(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attrs := m.mergedAttrs()
+	msgs := make([]PublishMessage, 0, len(records))
+	for _, record := range records {
+		value, err := m.codec.Encode(record)
+		if err != nil {
+			return fmt.Errorf("failed to encode record: %w", err)
+		}
+		msgs = append(msgs, PublishMessage{Value: value, Attrs: attrs})
+	}
+
+	if err := batch.PublishBatch(m.ctx, msgs); err != nil {
+		return fmt.Errorf("failed to publish batch: %w", err)
+	}
+	m.recordCount += len(records)
+	return nil
+}
+
+// Close releases the underlying Publisher's connection.
+func (m *MessageWriter) Close() error {
+	return m.pub.Close()
+}
+
+// GetPath returns the sink URI MessageWriter was opened with.
+func (m *MessageWriter) GetPath() string {
+	return m.uri
+}
+
+// GetRecordCount returns the number of records published.
+func (m *MessageWriter) GetRecordCount() int {
+	return m.recordCount
+}
+
+// OpenSink builds a StreamingWriter around a MessageWriter for uri (e.g.
+// kafka://broker:9092/topic or nats://host:4222/subject), so a --sink URI
+// buffers and batches exactly the way a file path does via
+// NewStreamingWriter.
+func OpenSink(ctx context.Context, uri string, bufferSize int, codec Codec, baseAttrs map[string]string) (*StreamingWriter, error) {
+	mw, err := NewMessageWriter(ctx, uri, codec, baseAttrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamingWriter{
+		writer:     mw,
+		buffer:     make([]types.Record, 0, bufferSize),
+		bufferSize: bufferSize,
+	}, nil
+}
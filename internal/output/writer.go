@@ -19,6 +19,14 @@ type Writer interface {
 	GetPath() string
 }
 
+// BatchWriter is implemented by a Writer that can write several records as
+// a single underlying operation (e.g. MessageWriter's one-produce-request-
+// per-batch publish) instead of one call per record. StreamingWriter.Flush
+// uses it when available, falling back to looping Write otherwise.
+type BatchWriter interface {
+	WriteBatch(records []types.Record) error
+}
+
 // JSONLWriter writes records in JSON Lines format
 type JSONLWriter struct {
 	writer     io.WriteCloser
@@ -166,6 +174,14 @@ func (s *StreamingWriter) Flush() error {
 		return nil
 	}
 
+	if batch, ok := s.writer.(BatchWriter); ok {
+		if err := batch.WriteBatch(s.buffer); err != nil {
+			return err
+		}
+		s.buffer = s.buffer[:0] // Clear buffer
+		return nil
+	}
+
 	for _, record := range s.buffer {
 		if err := s.writer.Write(record); err != nil {
 			return err
@@ -176,6 +192,16 @@ func (s *StreamingWriter) Flush() error {
 	return nil
 }
 
+// SetBatchAttrs forwards batch-scoped metadata (e.g. a batch id or
+// generated-at timestamp) to the underlying writer if it publishes messages
+// with per-batch attributes; it's a no-op for writers that don't, like
+// JSONLWriter.
+func (s *StreamingWriter) SetBatchAttrs(attrs map[string]string) {
+	if mw, ok := s.writer.(*MessageWriter); ok {
+		mw.SetBatchAttrs(attrs)
+	}
+}
+
 // Close flushes remaining records and closes the writer
 func (s *StreamingWriter) Close() error {
 	if err := s.Flush(); err != nil {
@@ -191,8 +217,12 @@ func (s *StreamingWriter) GetPath() string {
 
 // GetRecordCount returns the number of records written
 func (s *StreamingWriter) GetRecordCount() int {
-	if jsonlWriter, ok := s.writer.(*JSONLWriter); ok {
-		return jsonlWriter.GetRecordCount()
+	switch w := s.writer.(type) {
+	case *JSONLWriter:
+		return w.GetRecordCount()
+	case *MessageWriter:
+		return w.GetRecordCount()
+	default:
+		return 0
 	}
-	return 0
 }
@@ -0,0 +1,95 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity classifies how serious a Diagnostic is.
+type Severity int
+
+const (
+	// SeverityError marks a diagnostic that makes the specification invalid.
+	SeverityError Severity = iota
+	// SeverityWarning marks a diagnostic that is worth surfacing but does
+	// not by itself fail validation.
+	SeverityWarning
+)
+
+// String renders the severity the way format.Diagnostic's header expects.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "Error"
+	case SeverityWarning:
+		return "Warning"
+	default:
+		return "Unknown"
+	}
+}
+
+// Pos is a single location in a source file. Line and Column are
+// 1-indexed; Byte is a 0-indexed byte offset, mirroring hcl.Pos.
+type Pos struct {
+	Line   int
+	Column int
+	Byte   int
+}
+
+// Range is a span of source between two Pos, mirroring hcl.Range.
+type Range struct {
+	Filename string
+	Start    Pos
+	End      Pos
+}
+
+// Diagnostic is a single validation finding, optionally tied back to the
+// YAML source node it came from. Subject is nil when the finding wasn't
+// produced against a parsed Document (e.g. the legacy Validate entry
+// point), in which case format.Diagnostic falls back to a header-only
+// rendering.
+type Diagnostic struct {
+	Severity Severity
+	Summary  string
+	Detail   string
+	Subject  *Range
+
+	// Origin is the file a field-scoped diagnostic's field was ultimately
+	// defined in, for specs assembled by Loader from an extends base,
+	// imports, or a $ref target. Empty for diagnostics parsed from a
+	// single file, where Subject.Filename already says it all.
+	Origin string
+}
+
+// Diagnostics is an ordered collection of Diagnostic.
+type Diagnostics []*Diagnostic
+
+// HasErrors reports whether any diagnostic in the set is an error, as
+// opposed to a warning.
+func (ds Diagnostics) HasErrors() bool {
+	for _, d := range ds {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Error implements the error interface, so Diagnostics can be returned
+// anywhere an error is expected. It summarizes every diagnostic on its own
+// line; callers that want source snippets should use format.Diagnostic
+// instead.
+func (ds Diagnostics) Error() string {
+	lines := make([]string, len(ds))
+	for i, d := range ds {
+		if d.Subject != nil {
+			lines[i] = fmt.Sprintf("%s:%d:%d: %s: %s", d.Subject.Filename, d.Subject.Start.Line, d.Subject.Start.Column, d.Severity, d.Summary)
+		} else {
+			lines[i] = fmt.Sprintf("%s: %s", d.Severity, d.Summary)
+		}
+		if d.Origin != "" {
+			lines[i] += fmt.Sprintf(" (defined in %s)", d.Origin)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,160 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document pairs a decoded Specification with the yaml.Node tree it was
+// parsed from and its raw source bytes, so ValidateDocument's diagnostics
+// can point at the exact line/column a value came from.
+type Document struct {
+	Spec     *Specification
+	Filename string
+	Source   []byte
+	root     *yaml.Node
+
+	// FieldOrigins maps a dataset field's name to the file its final
+	// definition came from — its own file, an extends base, an import, or
+	// a $ref target. Set by Loader.Load for composed documents; nil for
+	// documents parsed directly by LoadDocument/ParseDocument, since a
+	// single file is its own and only origin.
+	FieldOrigins map[string]string
+}
+
+// LoadDocument reads and parses filename into a Document.
+func LoadDocument(filename string) (*Document, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return ParseDocument(filename, data)
+}
+
+// ParseDocument parses data (attributed to filename for diagnostics) into a
+// Document, decoding it once into a Specification and once into a yaml.Node
+// tree so each field can be traced back to its source position.
+func ParseDocument(filename string, data []byte) (*Document, error) {
+	var spec Specification
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	setDefaults(&spec)
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML node tree: %w", err)
+	}
+
+	return &Document{Spec: &spec, Filename: filename, Source: data, root: &root}, nil
+}
+
+// rangeOf walks the document's node tree along path (e.g. "model",
+// "batch_size") and returns the Range of the node at the end of it, or nil
+// if the path doesn't resolve — either because a key is absent or the
+// document failed to parse into a node tree.
+func (d *Document) rangeOf(path ...string) *Range {
+	if d == nil || d.root == nil {
+		return nil
+	}
+
+	node := documentRoot(d.root)
+	for _, key := range path {
+		node = mappingValue(node, key)
+		if node == nil {
+			return nil
+		}
+	}
+	return d.nodeRange(node)
+}
+
+// originOf returns the file fieldName's definition was ultimately merged
+// in from, or "" when d has no FieldOrigins (a document parsed directly
+// rather than through a Loader).
+func (d *Document) originOf(fieldName string) string {
+	if d == nil || d.FieldOrigins == nil {
+		return ""
+	}
+	return d.FieldOrigins[fieldName]
+}
+
+// fieldRangeOf returns the Range of the key field at dataset.fields[index],
+// or of the whole field mapping when key is "".
+func (d *Document) fieldRangeOf(index int, key string) *Range {
+	if d == nil || d.root == nil {
+		return nil
+	}
+
+	fields := mappingValue(mappingValue(documentRoot(d.root), "dataset"), "fields")
+	if fields == nil || fields.Kind != yaml.SequenceNode || index >= len(fields.Content) {
+		return nil
+	}
+
+	node := fields.Content[index]
+	if key != "" {
+		if v := mappingValue(node, key); v != nil {
+			node = v
+		}
+	}
+	return d.nodeRange(node)
+}
+
+// documentRoot unwraps a parsed yaml.Node's DocumentNode wrapper to reach
+// the top-level mapping.
+func documentRoot(root *yaml.Node) *yaml.Node {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		return root.Content[0]
+	}
+	return root
+}
+
+// mappingValue looks up key's value node within a mapping node, returning
+// nil if node isn't a mapping or doesn't contain key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// nodeRange converts a yaml.Node's line/column into a Range spanning its
+// rendered value, computing a byte offset from the document's source.
+func (d *Document) nodeRange(node *yaml.Node) *Range {
+	if node == nil {
+		return nil
+	}
+
+	start := Pos{Line: node.Line, Column: node.Column, Byte: byteOffset(d.Source, node.Line, node.Column)}
+	end := start
+	if width := len(node.Value); width > 0 {
+		end.Column += width
+		end.Byte += width
+	} else {
+		end.Column++
+		end.Byte++
+	}
+
+	return &Range{Filename: d.Filename, Start: start, End: end}
+}
+
+// byteOffset converts a 1-indexed (line, column) pair into a 0-indexed byte
+// offset into source.
+func byteOffset(source []byte, line, column int) int {
+	offset := 0
+	for l := 1; l < line; l++ {
+		idx := bytes.IndexByte(source[offset:], '\n')
+		if idx < 0 {
+			return offset
+		}
+		offset += idx + 1
+	}
+	return offset + column - 1
+}
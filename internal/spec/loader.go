@@ -0,0 +1,454 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Loader loads a Specification that may be composed from several YAML
+// files via three top-level directives: `extends: path` deep-merges a base
+// spec underneath the current one (current wins), `imports: [paths...]`
+// merges a list of partial specs the same way (e.g. a shared model: block
+// or a library of reusable fields:), and a field's `$ref:
+// "common.yaml#/fields/email"` resolves a JSON-Pointer into another file to
+// fill in that one field. It borrows the dependency/values-import pattern
+// chart-style tools use so related specs (customer, orders, products) can
+// share common settings instead of duplicating them.
+type Loader struct {
+	// Root is the directory extends, imports, and $ref file paths resolve
+	// against when they're relative. An empty Root resolves relative
+	// paths against the current working directory, matching LoadFromFile.
+	Root string
+}
+
+// NewLoader builds a Loader that resolves relative extends/imports/$ref
+// paths against root.
+func NewLoader(root string) *Loader {
+	return &Loader{Root: root}
+}
+
+// Load reads filename, deep-merges in everything it transitively extends
+// and imports, resolves every field's $ref, and decodes the result into a
+// Document. The Document's FieldOrigins records, for each dataset field,
+// the file that field's final definition came from, so ValidateDocument
+// can attribute post-merge diagnostics to the right source file.
+//
+// Composed documents lose the per-field line/column precision
+// LoadDocument's node tree gives a single file, since the merged YAML is
+// remarshaled rather than parsed from one source: Document.rangeOf and
+// fieldRangeOf resolve against the merged output, not the original files.
+// FieldOrigins exists to make up the difference.
+func (l *Loader) Load(filename string) (*Document, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	if !isComposed(raw) {
+		// No extends, imports, or $ref: parse filename directly so
+		// diagnostics keep their exact line/column, instead of paying the
+		// precision loss a merge-and-remarshal round trip costs a plain,
+		// self-contained spec.
+		return ParseDocument(filename, data)
+	}
+
+	c := &composer{root: l.Root, visiting: map[string]bool{}, origins: map[string]string{}}
+
+	merged, err := c.load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.resolveFieldRefs(merged); err != nil {
+		return nil, err
+	}
+
+	remarshaled, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remarshal composed spec: %w", err)
+	}
+
+	doc, err := ParseDocument(filename, remarshaled)
+	if err != nil {
+		return nil, err
+	}
+	doc.FieldOrigins = c.origins
+
+	return doc, nil
+}
+
+// isComposed reports whether raw uses any of the three composition
+// directives: a non-empty extends, a non-empty imports, or a dataset
+// field with a $ref.
+func isComposed(raw map[string]interface{}) bool {
+	if extends, _ := raw["extends"].(string); extends != "" {
+		return true
+	}
+	if len(asStringSlice(raw["imports"])) > 0 {
+		return true
+	}
+
+	dataset, _ := raw["dataset"].(map[string]interface{})
+	fields, _ := dataset["fields"].([]interface{})
+	for _, f := range fields {
+		if m, ok := f.(map[string]interface{}); ok {
+			if ref, _ := m["$ref"].(string); ref != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// composer carries the state a single Loader.Load call threads through its
+// recursive extends/imports/$ref resolution: visiting detects cycles by
+// absolute path, origins accumulates the originating file for every
+// dataset field seen so far.
+type composer struct {
+	root     string
+	visiting map[string]bool
+	origins  map[string]string // field name -> file it was last (re)defined in
+}
+
+// load reads path, merges in its extends base (if any) followed by its
+// imports (if any) underneath its own content, and records, for every
+// dataset field path sets directly, that path as the field's origin.
+// Earlier layers (extends, then imports, in order) are merged first so
+// the current file's own content — including re-declared fields — always
+// wins, per mergeMaps' "current takes precedence" rule.
+func (c *composer) load(path string) (map[string]interface{}, error) {
+	abs, err := c.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.visiting[abs] {
+		return nil, fmt.Errorf("spec composition cycle detected at %s", displayPath(abs))
+	}
+	c.visiting[abs] = true
+	defer delete(c.visiting, abs)
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", displayPath(abs), err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", displayPath(abs), err)
+	}
+
+	dir := filepath.Dir(abs)
+	merged := map[string]interface{}{}
+
+	if extends, ok := raw["extends"].(string); ok && extends != "" {
+		base, err := c.loadRelativeTo(dir, extends)
+		if err != nil {
+			return nil, fmt.Errorf("%s: extends %s: %w", displayPath(abs), extends, err)
+		}
+		merged = base
+	}
+
+	for _, imp := range asStringSlice(raw["imports"]) {
+		imported, err := c.loadRelativeTo(dir, imp)
+		if err != nil {
+			return nil, fmt.Errorf("%s: imports %s: %w", displayPath(abs), imp, err)
+		}
+		merged = mergeMaps(merged, imported, "")
+	}
+
+	own := map[string]interface{}{}
+	for k, v := range raw {
+		if k == "extends" || k == "imports" {
+			continue
+		}
+		own[k] = v
+	}
+	merged = mergeMaps(merged, own, "")
+
+	recordFieldOrigins(own, displayPath(abs), c.origins)
+
+	return merged, nil
+}
+
+// loadRelativeTo loads the spec at relPath, resolved against dir (relPath
+// itself wins over dir if it's already absolute).
+func (c *composer) loadRelativeTo(dir, relPath string) (map[string]interface{}, error) {
+	if filepath.IsAbs(relPath) {
+		return c.load(relPath)
+	}
+	return c.load(filepath.Join(dir, relPath))
+}
+
+// resolve turns path into an absolute path, relative to c.root when path
+// is itself relative and c.root is set.
+func (c *composer) resolve(path string) (string, error) {
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+	if c.root != "" {
+		path = filepath.Join(c.root, path)
+	}
+	return filepath.Abs(path)
+}
+
+// resolveFieldRefs walks merged's dataset.fields, replacing every entry
+// that carries a $ref with the Field it points to (local keys besides
+// $ref and name still win, same as extends), and records the ref target
+// as that field's origin.
+func (c *composer) resolveFieldRefs(merged map[string]interface{}) error {
+	dataset, _ := merged["dataset"].(map[string]interface{})
+	if dataset == nil {
+		return nil
+	}
+	fields, _ := dataset["fields"].([]interface{})
+
+	for i, raw := range fields {
+		field, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref, ok := field["$ref"].(string)
+		if !ok || ref == "" {
+			continue
+		}
+
+		resolved, originFile, err := c.resolveRef(ref)
+		if err != nil {
+			name, _ := field["name"].(string)
+			return fmt.Errorf("field %q: $ref %s: %w", name, ref, err)
+		}
+
+		override := map[string]interface{}{}
+		for k, v := range field {
+			if k != "$ref" {
+				override[k] = v
+			}
+		}
+
+		resolvedField := mergeMaps(resolved, override, "")
+		fields[i] = resolvedField
+
+		if name, _ := resolvedField["name"].(string); name != "" {
+			c.origins[name] = originFile
+		}
+	}
+
+	return nil
+}
+
+// resolveRef splits ref into its "path#/json/pointer" halves, loads path
+// (relative to c.root), and walks the pointer to find the Field it names.
+func (c *composer) resolveRef(ref string) (map[string]interface{}, string, error) {
+	filePart, pointer, found := strings.Cut(ref, "#")
+	if !found {
+		return nil, "", fmt.Errorf("missing \"#/json/pointer\" suffix")
+	}
+
+	abs, err := c.resolve(filePart)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", displayPath(abs), err)
+	}
+
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %w", displayPath(abs), err)
+	}
+
+	target, err := jsonPointerLookup(doc, pointer)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", displayPath(abs), err)
+	}
+
+	field, ok := target.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("%s%s does not point at a field definition", displayPath(abs), pointer)
+	}
+
+	return field, displayPath(abs), nil
+}
+
+// jsonPointerLookup walks doc along pointer (RFC 6901: "/a/b/2", with ~1
+// and ~0 escaping "/" and "~"), indexing maps by key and slices by
+// integer.
+func jsonPointerLookup(doc interface{}, pointer string) (interface{}, error) {
+	if pointer == "" {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with \"/\"", pointer)
+	}
+
+	current := doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(token, "~1", "/")
+		token = strings.ReplaceAll(token, "~0", "~")
+
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("no %q in JSON pointer %q", token, pointer)
+			}
+			current = next
+		case []interface{}:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, fmt.Errorf("index %q out of range in JSON pointer %q", token, pointer)
+			}
+			current = v[idx]
+		default:
+			return nil, fmt.Errorf("cannot index into %T at %q", current, pointer)
+		}
+	}
+	return current, nil
+}
+
+// mergeMaps deep-merges override on top of base: maps merge key by key,
+// override's scalars and slices replace base's outright, and override
+// wins whenever a key isn't itself a mergeable map — except path
+// "dataset.fields", which merges by each field's name so a child spec can
+// override a single field of an extended/imported parent without
+// redefining the rest. path is the dotted key path merge has descended
+// to so far ("" at the root); pass "" from callers.
+func mergeMaps(base, override map[string]interface{}, path string) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		result[k] = v
+	}
+
+	for k, v := range override {
+		childPath := k
+		if path != "" {
+			childPath = path + "." + k
+		}
+
+		if childPath == "dataset.fields" {
+			baseFields, _ := result[k].([]interface{})
+			overrideFields, ok := v.([]interface{})
+			if ok {
+				result[k] = mergeFieldSlice(baseFields, overrideFields)
+				continue
+			}
+		}
+
+		if baseMap, ok := result[k].(map[string]interface{}); ok {
+			if overrideMap, ok := v.(map[string]interface{}); ok {
+				result[k] = mergeMaps(baseMap, overrideMap, childPath)
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// mergeFieldSlice merges override onto base by each entry's "name": a
+// name shared by both sides merges (override's keys win), a name unique
+// to override is appended, and base entries override doesn't mention are
+// left untouched.
+func mergeFieldSlice(base, override []interface{}) []interface{} {
+	result := make([]interface{}, len(base))
+	copy(result, base)
+
+	indexByName := make(map[string]int, len(base))
+	for i, f := range base {
+		if m, ok := f.(map[string]interface{}); ok {
+			if name, _ := m["name"].(string); name != "" {
+				indexByName[name] = i
+			}
+		}
+	}
+
+	for _, f := range override {
+		m, ok := f.(map[string]interface{})
+		if !ok {
+			result = append(result, f)
+			continue
+		}
+		name, _ := m["name"].(string)
+		if name == "" {
+			result = append(result, f)
+			continue
+		}
+		if idx, exists := indexByName[name]; exists {
+			if baseField, ok := result[idx].(map[string]interface{}); ok {
+				result[idx] = mergeMaps(baseField, m, "")
+				continue
+			}
+		}
+		indexByName[name] = len(result)
+		result = append(result, m)
+	}
+
+	return result
+}
+
+// recordFieldOrigins marks file as the origin of every named field in
+// own's dataset.fields, overwriting whatever origin an earlier (extends
+// or imports) layer recorded for the same name.
+func recordFieldOrigins(own map[string]interface{}, file string, origins map[string]string) {
+	dataset, _ := own["dataset"].(map[string]interface{})
+	if dataset == nil {
+		return
+	}
+	fields, _ := dataset["fields"].([]interface{})
+	for _, f := range fields {
+		m, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := m["name"].(string); name != "" {
+			origins[name] = file
+		}
+	}
+}
+
+// asStringSlice converts a YAML-decoded `imports:` value (a []interface{}
+// of strings) into a []string, ignoring anything that isn't a string.
+func asStringSlice(v interface{}) []string {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, e := range raw {
+		if s, ok := e.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// displayPath renders abs relative to the working directory when that's
+// shorter, so origins and error messages read like the paths a user typed
+// rather than an absolute filesystem path.
+func displayPath(abs string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return abs
+	}
+	rel, err := filepath.Rel(wd, abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return abs
+	}
+	return rel
+}
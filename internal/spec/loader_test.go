@@ -0,0 +1,168 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeSpecFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoaderExtendsMergesBaseUnderneathChild(t *testing.T) {
+	dir := t.TempDir()
+
+	writeSpecFile(t, dir, "base.yaml", `
+dataset:
+  domain: base-domain
+  count: 10
+  fields:
+    - name: id
+      type: string
+    - name: email
+      type: string
+`)
+
+	childPath := writeSpecFile(t, dir, "child.yaml", `
+extends: base.yaml
+dataset:
+  count: 25
+  fields:
+    - name: email
+      type: email
+`)
+
+	doc, err := NewLoader(dir).Load(childPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if doc.Spec.Dataset.Domain != "base-domain" {
+		t.Errorf("Dataset.Domain = %q, want inherited %q", doc.Spec.Dataset.Domain, "base-domain")
+	}
+	if doc.Spec.Dataset.Count != 25 {
+		t.Errorf("Dataset.Count = %d, want child's override 25", doc.Spec.Dataset.Count)
+	}
+
+	byName := fieldsByName(doc.Spec.Dataset.Fields)
+	if byName["id"].Type != "string" {
+		t.Errorf("field %q untouched by child, Type = %q, want %q", "id", byName["id"].Type, "string")
+	}
+	if byName["email"].Type != "email" {
+		t.Errorf("field %q overridden by child, Type = %q, want %q", "email", byName["email"].Type, "email")
+	}
+}
+
+func TestLoaderImportsMergeInOrder(t *testing.T) {
+	dir := t.TempDir()
+
+	writeSpecFile(t, dir, "model-defaults.yaml", `
+model:
+  name: llama3.1:8b
+`)
+	writeSpecFile(t, dir, "shared-fields.yaml", `
+dataset:
+  fields:
+    - name: created_at
+      type: string
+`)
+
+	mainPath := writeSpecFile(t, dir, "main.yaml", `
+imports:
+  - model-defaults.yaml
+  - shared-fields.yaml
+dataset:
+  domain: orders
+  count: 5
+  fields:
+    - name: order_id
+      type: string
+`)
+
+	doc, err := NewLoader(dir).Load(mainPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if doc.Spec.Model.Name != "llama3.1:8b" {
+		t.Errorf("Model.Name = %q, want imported %q", doc.Spec.Model.Name, "llama3.1:8b")
+	}
+
+	byName := fieldsByName(doc.Spec.Dataset.Fields)
+	if _, ok := byName["created_at"]; !ok {
+		t.Error("expected imported field \"created_at\" to be present")
+	}
+	if _, ok := byName["order_id"]; !ok {
+		t.Error("expected main.yaml's own field \"order_id\" to be present")
+	}
+}
+
+func TestLoaderResolvesFieldRef(t *testing.T) {
+	dir := t.TempDir()
+
+	writeSpecFile(t, dir, "common.yaml", `
+fields:
+  email:
+    name: email
+    type: email
+    required: true
+`)
+
+	mainPath := writeSpecFile(t, dir, "main.yaml", `
+dataset:
+  domain: customers
+  count: 5
+  fields:
+    - $ref: common.yaml#/fields/email
+`)
+
+	doc, err := NewLoader(dir).Load(mainPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	byName := fieldsByName(doc.Spec.Dataset.Fields)
+	email, ok := byName["email"]
+	if !ok {
+		t.Fatal("expected $ref-resolved field \"email\" to be present")
+	}
+	if email.Type != "email" || !email.Required {
+		t.Errorf("resolved field = %+v, want Type=email Required=true", email)
+	}
+}
+
+func TestLoaderDetectsExtendsCycle(t *testing.T) {
+	dir := t.TempDir()
+
+	writeSpecFile(t, dir, "a.yaml", `
+extends: b.yaml
+dataset:
+  domain: a
+  count: 1
+`)
+	bPath := writeSpecFile(t, dir, "b.yaml", `
+extends: a.yaml
+dataset:
+  domain: b
+  count: 1
+`)
+
+	_, err := NewLoader(dir).Load(bPath)
+	if err == nil {
+		t.Fatal("Load() error = nil, want a cycle-detection error")
+	}
+}
+
+func fieldsByName(fields []Field) map[string]Field {
+	byName := make(map[string]Field, len(fields))
+	for _, f := range fields {
+		byName[f.Name] = f
+	}
+	return byName
+}
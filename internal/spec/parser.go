@@ -1,9 +1,11 @@
 package spec
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -28,17 +30,25 @@ func LoadFromFile(filename string) (*Specification, error) {
 	return ParseYAML(data)
 }
 
-// ParseYAML parses a specification from YAML bytes
+// ParseYAML parses a specification from YAML bytes. It runs ValidateSchema
+// first, so structural problems (an unknown field, a batch_size out of
+// range, an enum field missing values) are reported with a JSON-Pointer
+// location before semantic validation (Validate/ValidateDocument) ever
+// sees a decoded Specification.
 func ParseYAML(data []byte) (*Specification, error) {
+	if err := ValidateSchema(data); err != nil {
+		return nil, err
+	}
+
 	var spec Specification
-	
+
 	if err := yaml.Unmarshal(data, &spec); err != nil {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
 	}
-	
+
 	// Set defaults
 	setDefaults(&spec)
-	
+
 	return &spec, nil
 }
 
@@ -66,25 +76,63 @@ func ToYAML(spec *Specification) (string, error) {
 	return string(data), nil
 }
 
+// ModelDefaults holds the fallback values setDefaults applies to a
+// specification's Model block when a field is left unset. SetModelDefaults
+// overrides them, typically once at startup from resolved CLI configuration
+// (flags/env/profile/config file); specs parsed before the override use
+// whatever defaults were in effect at the time.
+type ModelDefaults struct {
+	Endpoint    string
+	Name        string
+	BatchSize   int
+	Temperature float64
+	Timeout     string
+	Provider    string
+}
+
+var modelDefaults = ModelDefaults{
+	Endpoint:    "http://localhost:11434",
+	Name:        "llama3.1:8b",
+	BatchSize:   32,
+	Temperature: 0.7,
+	Timeout:     "30s",
+	Provider:    "ollama",
+}
+
+// SetModelDefaults overrides the fallback values setDefaults uses for a
+// spec's Model block.
+func SetModelDefaults(d ModelDefaults) {
+	modelDefaults = d
+}
+
 // setDefaults sets default values for missing fields
 func setDefaults(spec *Specification) {
 	// Model defaults
 	if spec.Model.Endpoint == "" {
-		spec.Model.Endpoint = "http://localhost:11434"
+		spec.Model.Endpoint = modelDefaults.Endpoint
 	}
 	if spec.Model.Name == "" {
-		spec.Model.Name = "llama3.1:8b"
+		spec.Model.Name = modelDefaults.Name
 	}
 	if spec.Model.BatchSize == 0 {
-		spec.Model.BatchSize = 32
+		spec.Model.BatchSize = modelDefaults.BatchSize
 	}
 	if spec.Model.Temperature == 0 {
-		spec.Model.Temperature = 0.7
+		spec.Model.Temperature = modelDefaults.Temperature
 	}
 	if spec.Model.Timeout == "" {
-		spec.Model.Timeout = "30s"
+		spec.Model.Timeout = modelDefaults.Timeout
 	}
-	
+	if spec.Model.Provider == "" {
+		spec.Model.Provider = modelDefaults.Provider
+	}
+
+	// A schema-driven dataset derives its field list from Schema instead of
+	// spelling it out under fields.
+	if len(spec.Dataset.Fields) == 0 && len(spec.Dataset.Schema) > 0 {
+		spec.Dataset.Fields = deriveFieldsFromSchema(spec.Dataset.Schema)
+	}
+
 	// Dataset defaults
 	if spec.Dataset.Count == 0 {
 		spec.Dataset.Count = 1000
@@ -106,144 +154,400 @@ func setDefaults(spec *Specification) {
 	}
 }
 
-// Validate validates a specification for correctness
-func Validate(spec *Specification) error {
-	if err := validateModel(&spec.Model); err != nil {
-		return fmt.Errorf("model validation failed: %w", err)
+// schemaTypeToFieldType maps a JSON Schema "type"/"format" pair to the
+// closest validFieldTypes entry, for deriveFieldsFromSchema's best-effort
+// translation. It only covers the formats the generation prompt and
+// generateDemoData know how to render something plausible for; anything
+// else (e.g. "ipv4"/"ipv6") falls back to the schema type alone, since
+// structural correctness for those still comes from Ollama's "format"
+// constraint and validateRecord's schema validation, not this heuristic.
+func schemaTypeToFieldType(schemaType, format string) string {
+	switch schemaType {
+	case "integer":
+		return "integer"
+	case "number":
+		return "float"
+	case "boolean":
+		return "boolean"
+	case "object":
+		return "object"
+	case "array":
+		return "array"
+	case "string":
+		switch format {
+		case "date-time":
+			return "datetime"
+		case "date":
+			return "date"
+		case "time":
+			return "time"
+		case "email":
+			return "email"
+		case "uri", "url":
+			return "url"
+		case "uuid":
+			return "uuid"
+		default:
+			return "string"
+		}
+	default:
+		return "string"
 	}
-	
-	if err := validateDataset(&spec.Dataset); err != nil {
-		return fmt.Errorf("dataset validation failed: %w", err)
+}
+
+// deriveFieldsFromSchema builds a Field list from a JSON Schema (or OpenAPI
+// 3 components.schemas entry) document's top-level "properties", for a
+// dataset that sets Schema instead of spelling fields out directly. It's
+// deliberately shallow: it reads "type"/"format"/"description"/"pattern"/
+// "enum"/"minimum"/"maximum" off each property, but doesn't resolve $ref or
+// recurse into oneOf/nested object properties — those still reach Ollama
+// intact via the schema itself (passed through as the request's "format"),
+// they just won't get bespoke prompt text or demo-data generation.
+func deriveFieldsFromSchema(schema map[string]interface{}) []Field {
+	properties, _ := schema["properties"].(map[string]interface{})
+	if len(properties) == 0 {
+		return nil
+	}
+
+	required := map[string]bool{}
+	if reqList, ok := schema["required"].([]interface{}); ok {
+		for _, r := range reqList {
+			if name, ok := r.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+
+	// Map iteration order isn't stable; sort names so repeated runs (and
+	// diffs) produce the same field order.
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]Field, 0, len(names))
+	for _, name := range names {
+		prop, _ := properties[name].(map[string]interface{})
+
+		field := Field{Name: name, Required: required[name]}
+
+		schemaType, _ := prop["type"].(string)
+		format, _ := prop["format"].(string)
+		field.Type = schemaTypeToFieldType(schemaType, format)
+
+		if desc, ok := prop["description"].(string); ok {
+			field.Description = desc
+		}
+		if pattern, ok := prop["pattern"].(string); ok {
+			field.Pattern = pattern
+			field.Type = "string"
+		}
+
+		if enum, ok := prop["enum"].([]interface{}); ok && len(enum) > 0 {
+			field.Type = "enum"
+			for _, v := range enum {
+				if s, ok := v.(string); ok {
+					field.Values = append(field.Values, s)
+				} else {
+					field.Values = append(field.Values, fmt.Sprintf("%v", v))
+				}
+			}
+		}
+
+		if min, ok := asInt(prop["minimum"]); ok {
+			if max, ok := asInt(prop["maximum"]); ok {
+				field.Range = []int{min, max}
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// asInt converts a decoded JSON Schema numeric bound (float64 from JSON, or
+// int/int64 if the spec came from YAML) to an int, reporting false if v
+// isn't numeric.
+func asInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// Validate validates a specification for correctness, returning the first
+// problem found as a plain error. Prefer ValidateDocument, which collects
+// every problem instead of failing fast and annotates each one with the
+// source line/column it came from.
+func Validate(spec *Specification) error {
+	diags := validate(nil, spec)
+	if diags.HasErrors() {
+		return errors.New(diags.Error())
 	}
-	
 	return nil
 }
 
+// ValidateDocument validates doc.Spec, collecting every problem found (not
+// just the first) and tying each one back to the YAML node it came from via
+// doc's parsed node tree.
+func ValidateDocument(doc *Document) Diagnostics {
+	return validate(doc, doc.Spec)
+}
+
+// validate runs every validation pass against spec, optionally resolving
+// each finding's source Range against doc. doc may be nil, in which case
+// every diagnostic's Subject is nil.
+func validate(doc *Document, spec *Specification) Diagnostics {
+	var diags Diagnostics
+	diags = append(diags, validateModel(doc, &spec.Model)...)
+	diags = append(diags, validateDataset(doc, &spec.Dataset)...)
+	return diags
+}
+
+// providersWithBuiltinEndpoint are internal/llm/provider backends that ship
+// a compiled-in default endpoint (see their New constructors), so a spec
+// targeting one of them doesn't need to set model.endpoint itself.
+var providersWithBuiltinEndpoint = map[string]bool{
+	"anthropic": true,
+	"google":    true,
+	"gemini":    true,
+}
+
 // validateModel validates the model configuration
-func validateModel(model *ModelConfig) error {
-	if model.Endpoint == "" {
-		return fmt.Errorf("model endpoint is required")
+func validateModel(doc *Document, model *ModelConfig) Diagnostics {
+	var diags Diagnostics
+
+	if model.Endpoint == "" && !providersWithBuiltinEndpoint[model.Provider] {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Model endpoint is required",
+			Detail:   "Set model.endpoint to the base URL of an Ollama-compatible server, e.g. http://localhost:11434.",
+			Subject:  doc.rangeOf("model"),
+		})
+	} else if model.Endpoint != "" && !strings.HasPrefix(model.Endpoint, "http://") && !strings.HasPrefix(model.Endpoint, "https://") {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Model endpoint must be a valid HTTP/HTTPS URL",
+			Detail:   fmt.Sprintf("Got %q.", model.Endpoint),
+			Subject:  doc.rangeOf("model", "endpoint"),
+		})
 	}
-	
+
 	if model.Name == "" {
-		return fmt.Errorf("model name is required")
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Model name is required",
+			Detail:   "Set model.name to an installed Ollama model, e.g. llama3.1:8b.",
+			Subject:  doc.rangeOf("model"),
+		})
 	}
-	
+
 	if model.BatchSize <= 0 {
-		return fmt.Errorf("batch size must be positive, got %d", model.BatchSize)
-	}
-	
-	if model.BatchSize > 1000 {
-		return fmt.Errorf("batch size too large (max 1000), got %d", model.BatchSize)
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Batch size must be positive",
+			Detail:   fmt.Sprintf("Got %d.", model.BatchSize),
+			Subject:  doc.rangeOf("model", "batch_size"),
+		})
+	} else if model.BatchSize > 1000 {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Batch size too large",
+			Detail:   fmt.Sprintf("Got %d; the maximum is 1000.", model.BatchSize),
+			Subject:  doc.rangeOf("model", "batch_size"),
+		})
 	}
-	
+
 	if model.Temperature < 0 || model.Temperature > 2 {
-		return fmt.Errorf("temperature must be between 0 and 2, got %.2f", model.Temperature)
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Temperature out of range",
+			Detail:   fmt.Sprintf("Got %.2f; temperature must be between 0 and 2.", model.Temperature),
+			Subject:  doc.rangeOf("model", "temperature"),
+		})
 	}
-	
-	// Validate endpoint format (basic check)
-	if !strings.HasPrefix(model.Endpoint, "http://") && !strings.HasPrefix(model.Endpoint, "https://") {
-		return fmt.Errorf("endpoint must be a valid HTTP/HTTPS URL")
-	}
-	
-	return nil
+
+	return diags
 }
 
 // validateDataset validates the dataset configuration
-func validateDataset(dataset *DatasetConfig) error {
+func validateDataset(doc *Document, dataset *DatasetConfig) Diagnostics {
+	var diags Diagnostics
+
 	if dataset.Count <= 0 {
-		return fmt.Errorf("record count must be positive, got %d", dataset.Count)
-	}
-	
-	if dataset.Count > 10000000 { // 10M limit
-		return fmt.Errorf("record count too large (max 10M), got %d", dataset.Count)
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Record count must be positive",
+			Detail:   fmt.Sprintf("Got %d.", dataset.Count),
+			Subject:  doc.rangeOf("dataset", "count"),
+		})
+	} else if dataset.Count > 10000000 { // 10M limit
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Record count too large",
+			Detail:   fmt.Sprintf("Got %d; the maximum is 10,000,000.", dataset.Count),
+			Subject:  doc.rangeOf("dataset", "count"),
+		})
 	}
-	
+
 	if dataset.Domain == "" {
-		return fmt.Errorf("domain description is required")
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Domain description is required",
+			Detail:   "Set dataset.domain to a short description of what the generated records represent.",
+			Subject:  doc.rangeOf("dataset"),
+		})
 	}
-	
+
 	if len(dataset.Fields) == 0 {
-		return fmt.Errorf("at least one field is required")
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "At least one field is required",
+			Detail:   "Add an entry under dataset.fields describing a column to generate.",
+			Subject:  doc.rangeOf("dataset"),
+		})
+	} else if len(dataset.Fields) > 100 {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Too many fields",
+			Detail:   fmt.Sprintf("Got %d; the maximum is 100.", len(dataset.Fields)),
+			Subject:  doc.rangeOf("dataset", "fields"),
+		})
 	}
-	
-	if len(dataset.Fields) > 100 {
-		return fmt.Errorf("too many fields (max 100), got %d", len(dataset.Fields))
-	}
-	
-	// Validate fields
+
 	fieldNames := make(map[string]bool)
 	for i, field := range dataset.Fields {
-		if err := validateField(&field, i); err != nil {
-			return fmt.Errorf("field '%s': %w", field.Name, err)
-		}
-		
-		// Check for duplicate field names
-		if fieldNames[field.Name] {
-			return fmt.Errorf("duplicate field name: %s", field.Name)
+		diags = append(diags, validateField(doc, &field, i)...)
+
+		if field.Name != "" && fieldNames[field.Name] {
+			diags = append(diags, &Diagnostic{
+				Severity: SeverityError,
+				Summary:  fmt.Sprintf("Duplicate field name %q", field.Name),
+				Detail:   "Every field under dataset.fields must have a unique name.",
+				Subject:  doc.fieldRangeOf(i, "name"),
+				Origin:   doc.originOf(field.Name),
+			})
 		}
 		fieldNames[field.Name] = true
 	}
-	
-	return nil
+
+	return diags
+}
+
+// validFieldTypes lists every field.type value FauxFoundry knows how to
+// generate.
+var validFieldTypes = []string{
+	"string", "text", "integer", "float", "boolean", "datetime", "date", "time",
+	"email", "url", "uuid", "phone", "enum", "object", "array",
 }
 
 // validateField validates a single field configuration
-func validateField(field *Field, index int) error {
+func validateField(doc *Document, field *Field, index int) Diagnostics {
+	var diags Diagnostics
+
 	if field.Name == "" {
-		return fmt.Errorf("field name is required (field %d)", index)
-	}
-	
-	// Validate field name format
-	if !isValidFieldName(field.Name) {
-		return fmt.Errorf("invalid field name '%s' (must be alphanumeric with underscores)", field.Name)
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  fmt.Sprintf("Field %d is missing a name", index),
+			Detail:   "Every entry under dataset.fields must set name.",
+			Subject:  doc.fieldRangeOf(index, ""),
+		})
+	} else if !isValidFieldName(field.Name) {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  fmt.Sprintf("Invalid field name %q", field.Name),
+			Detail:   "Field names must start with a letter or underscore and contain only letters, digits, and underscores.",
+			Subject:  doc.fieldRangeOf(index, "name"),
+		})
 	}
-	
+
 	if field.Type == "" {
-		return fmt.Errorf("field type is required")
-	}
-	
-	// Validate field type
-	validTypes := []string{
-		"string", "text", "integer", "float", "boolean", "datetime", "date", "time",
-		"email", "url", "uuid", "phone", "enum", "object", "array",
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  "Field type is required",
+			Detail:   fmt.Sprintf("Set type on field %q to one of: %s.", field.Name, strings.Join(validFieldTypes, ", ")),
+			Subject:  doc.fieldRangeOf(index, ""),
+		})
+	} else if !isValidFieldType(field.Type) {
+		diags = append(diags, &Diagnostic{
+			Severity: SeverityError,
+			Summary:  fmt.Sprintf("Invalid field type %q", field.Type),
+			Detail:   fmt.Sprintf("Valid types are: %s.", strings.Join(validFieldTypes, ", ")),
+			Subject:  doc.fieldRangeOf(index, "type"),
+		})
 	}
-	
-	isValidType := false
-	for _, validType := range validTypes {
-		if field.Type == validType {
-			isValidType = true
-			break
-		}
-	}
-	
-	if !isValidType {
-		return fmt.Errorf("invalid field type '%s', valid types: %v", field.Type, validTypes)
-	}
-	
-	// Type-specific validation
+
 	switch field.Type {
 	case "enum":
 		if len(field.Values) == 0 {
-			return fmt.Errorf("enum type requires values")
+			diags = append(diags, &Diagnostic{
+				Severity: SeverityError,
+				Summary:  "Enum field requires values",
+				Detail:   fmt.Sprintf("Set values on field %q to the list of allowed choices.", field.Name),
+				Subject:  doc.fieldRangeOf(index, ""),
+			})
 		}
 	case "integer", "float":
 		if len(field.Range) > 0 && len(field.Range) != 2 {
-			return fmt.Errorf("range must have exactly 2 values [min, max]")
-		}
-		if len(field.Range) == 2 && field.Range[0] >= field.Range[1] {
-			return fmt.Errorf("range min (%d) must be less than max (%d)", field.Range[0], field.Range[1])
+			diags = append(diags, &Diagnostic{
+				Severity: SeverityError,
+				Summary:  "Range must have exactly 2 values",
+				Detail:   fmt.Sprintf("Field %q's range must be [min, max].", field.Name),
+				Subject:  doc.fieldRangeOf(index, "range"),
+			})
+		} else if len(field.Range) == 2 && field.Range[0] >= field.Range[1] {
+			diags = append(diags, &Diagnostic{
+				Severity: SeverityError,
+				Summary:  "Range minimum must be less than maximum",
+				Detail:   fmt.Sprintf("Field %q has range [%d, %d].", field.Name, field.Range[0], field.Range[1]),
+				Subject:  doc.fieldRangeOf(index, "range"),
+			})
 		}
 	}
-	
-	// Validate pattern if provided
+
 	if field.Pattern != "" {
 		if _, err := regexp.Compile(field.Pattern); err != nil {
-			return fmt.Errorf("invalid regex pattern '%s': %w", field.Pattern, err)
+			diags = append(diags, &Diagnostic{
+				Severity: SeverityError,
+				Summary:  fmt.Sprintf("Invalid regex pattern on field %q", field.Name),
+				Detail:   err.Error(),
+				Subject:  doc.fieldRangeOf(index, "pattern"),
+			})
 		}
 	}
-	
-	return nil
+
+	if origin := doc.originOf(field.Name); origin != "" {
+		for _, d := range diags {
+			d.Origin = origin
+		}
+	}
+
+	return diags
+}
+
+// ValidFieldTypes returns every field.type value FauxFoundry knows how to
+// generate, for callers (e.g. the TUI's spec editor) that need to offer the
+// same set validateField enforces without duplicating it.
+func ValidFieldTypes() []string {
+	return append([]string(nil), validFieldTypes...)
+}
+
+// isValidFieldType reports whether t is one of validFieldTypes.
+func isValidFieldType(t string) bool {
+	for _, validType := range validFieldTypes {
+		if t == validType {
+			return true
+		}
+	}
+	return false
 }
 
 // isValidFieldName checks if a field name is valid (alphanumeric + underscores)
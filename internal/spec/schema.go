@@ -0,0 +1,219 @@
+package spec
+
+// JSONSchema returns the canonical JSON Schema (draft 2020-12) describing a
+// Specification, as a plain value ready for json.Marshal. It's the single
+// source of truth both ValidateSchema (ParseYAML's first pass) and the
+// `fauxfoundry schema` command draw from, so editors (the VS Code YAML
+// extension, most IDEs) and CI linters pointed at this document enforce
+// exactly the structural constraints validateModel/validateDataset/
+// validateField do in Go — batch size 1-1000, temperature 0-2, record
+// count 1-10,000,000, the field name pattern, and the per-type enum/range
+// requirements.
+func JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"$id":                  "https://github.com/copyleftdev/faux-foundry/schema/specification.json",
+		"title":                "FauxFoundry Specification",
+		"description":          "A FauxFoundry YAML specification: the model backend to generate with and the dataset shape to generate.",
+		"type":                 "object",
+		"required":             []string{"dataset"},
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"extends": map[string]interface{}{
+				"type":        "string",
+				"description": "Path to a base spec to deep-merge underneath this one.",
+			},
+			"imports": map[string]interface{}{
+				"type":        "array",
+				"description": "Paths to partial specs (e.g. a shared model: block or field library) to merge underneath this one.",
+				"items":       map[string]interface{}{"type": "string"},
+			},
+			"model":   modelSchema(),
+			"dataset": datasetSchema(),
+		},
+	}
+}
+
+// modelSchema describes ModelConfig, mirroring validateModel's bounds.
+func modelSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"endpoint": map[string]interface{}{
+				"type":        "string",
+				"pattern":     "^https?://",
+				"description": "Base URL of an Ollama-compatible server, e.g. http://localhost:11434.",
+			},
+			"name": map[string]interface{}{
+				"type":        "string",
+				"minLength":   1,
+				"description": "An installed Ollama model, e.g. llama3.1:8b.",
+			},
+			"batch_size": map[string]interface{}{
+				"type":        "integer",
+				"minimum":     1,
+				"maximum":     1000,
+				"description": "Records requested per generation call.",
+			},
+			"temperature": map[string]interface{}{
+				"type":    "number",
+				"minimum": 0,
+				"maximum": 2,
+			},
+			"timeout": map[string]interface{}{
+				"type":        "string",
+				"description": "A Go duration string, e.g. 30s.",
+			},
+			"provider": map[string]interface{}{
+				"type":        "string",
+				"description": "Backend registered with internal/llm/provider, e.g. ollama, openai, anthropic, gemini.",
+			},
+			"api_key":     map[string]interface{}{"type": "string"},
+			"concurrency": map[string]interface{}{"type": "integer", "minimum": 0},
+		},
+	}
+}
+
+// datasetSchema describes DatasetConfig, mirroring validateDataset's bounds.
+func datasetSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		// domain and count aren't required here even though
+		// validateDataset rejects a missing domain: setDefaults fills
+		// both in before that semantic pass ever runs. Neither fields nor
+		// schema has such a default, but setDefaults derives fields from
+		// schema when fields is empty, so at least one of the two must be
+		// given structurally.
+		"anyOf": []interface{}{
+			map[string]interface{}{"required": []string{"fields"}},
+			map[string]interface{}{"required": []string{"schema"}},
+		},
+		"properties": map[string]interface{}{
+			"count": map[string]interface{}{
+				"type":        "integer",
+				"minimum":     1,
+				"maximum":     10000000,
+				"description": "Number of unique records to generate.",
+			},
+			"domain": map[string]interface{}{
+				"type":        "string",
+				"minLength":   1,
+				"description": "Short description of what the generated records represent.",
+			},
+			"fields": map[string]interface{}{
+				"type":     "array",
+				"minItems": 1,
+				"maxItems": 100,
+				"items":    fieldSchema(),
+			},
+			"schema": map[string]interface{}{
+				"type":        "object",
+				"description": "A JSON Schema (or OpenAPI 3 components.schemas entry) describing a generated record, used to derive fields and constrain/validate generation in place of the fields list.",
+			},
+		},
+	}
+}
+
+// fieldSchema describes a single dataset.fields entry, mirroring
+// validateField. The base "properties"/"required" describe every field
+// shape at once; the "oneOf" branches narrow per field.type, the way
+// validateField's type switch requires "values" for "enum" and allows a
+// 2-element "range" for "integer"/"float".
+func fieldSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []string{"name", "type"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"pattern":     "^[A-Za-z_][A-Za-z0-9_]*$",
+				"maxLength":   50,
+				"description": "Column name; must start with a letter or underscore.",
+			},
+			"type": map[string]interface{}{
+				"type": "string",
+				"enum": append([]string{}, validFieldTypes...),
+			},
+			"description": map[string]interface{}{"type": "string"},
+			"required":    map[string]interface{}{"type": "boolean"},
+			"pattern": map[string]interface{}{
+				"type":        "string",
+				"description": "A regular expression generated values must match.",
+			},
+			"range": map[string]interface{}{
+				"type":        "array",
+				"minItems":    2,
+				"maxItems":    2,
+				"items":       map[string]interface{}{"type": "integer"},
+				"description": "[min, max] bounds for an integer or float field.",
+			},
+			"values": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Allowed choices for an enum field.",
+			},
+			// default accepts any JSON value, so it has no "type".
+			"default": map[string]interface{}{},
+			"$ref": map[string]interface{}{
+				"type":        "string",
+				"description": "A \"path#/json/pointer\" reference to a Field defined in another file.",
+			},
+			"tools": map[string]interface{}{
+				"type":        "array",
+				"description": "Local tool calls (see internal/agents) that deterministically derive this field's value instead of leaving it to the model.",
+				"items": map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"name"},
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Registered tool name, e.g. lookup_reference, regex_sample, faker, or sql_query.",
+						},
+						"args": map[string]interface{}{
+							"type":        "object",
+							"description": "Arguments passed to the tool, specific to each tool's name.",
+						},
+					},
+				},
+			},
+		},
+		"oneOf": []map[string]interface{}{
+			{
+				"properties": map[string]interface{}{
+					"type":   map[string]interface{}{"const": "enum"},
+					"values": map[string]interface{}{"minItems": 1},
+				},
+				"required": []string{"values"},
+			},
+			{
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{"enum": []string{"integer", "float"}},
+				},
+			},
+			{
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{"enum": otherFieldTypes()},
+				},
+			},
+		},
+	}
+}
+
+// otherFieldTypes returns validFieldTypes minus the ones that get their
+// own oneOf branch above ("enum", "integer", "float"), so the fallback
+// branch matches every remaining type exactly once.
+func otherFieldTypes() []string {
+	var out []string
+	for _, t := range validFieldTypes {
+		switch t {
+		case "enum", "integer", "float":
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
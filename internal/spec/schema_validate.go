@@ -0,0 +1,80 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+)
+
+const schemaResourceURL = "https://github.com/copyleftdev/faux-foundry/schema/specification.json"
+
+// compiledSchema lazily compiles JSONSchema() once; every ValidateSchema
+// call reuses it rather than recompiling the same document per spec.
+var (
+	compiledSchemaOnce sync.Once
+	compiledSchemaVal  *jsonschema.Schema
+	compiledSchemaErr  error
+)
+
+func compiledSchema() (*jsonschema.Schema, error) {
+	compiledSchemaOnce.Do(func() {
+		raw, err := json.Marshal(JSONSchema())
+		if err != nil {
+			compiledSchemaErr = fmt.Errorf("failed to marshal JSON schema: %w", err)
+			return
+		}
+
+		compiler := jsonschema.NewCompiler()
+		compiler.Draft = jsonschema.Draft2020
+		if err := compiler.AddResource(schemaResourceURL, bytes.NewReader(raw)); err != nil {
+			compiledSchemaErr = fmt.Errorf("failed to load JSON schema: %w", err)
+			return
+		}
+		compiledSchemaVal, compiledSchemaErr = compiler.Compile(schemaResourceURL)
+	})
+	return compiledSchemaVal, compiledSchemaErr
+}
+
+// ValidateSchema validates data (a YAML specification's raw bytes) against
+// JSONSchema() — the structural pass ParseYAML runs first, before
+// semantic validation (Validate/ValidateDocument) ever sees the decoded
+// Specification. It's exported separately so editor integrations and other
+// callers that only want "is this well-formed" (e.g. validate-as-you-type)
+// don't have to decode a full Specification to get it.
+//
+// A failure is a *jsonschema.ValidationError, whose Causes carry a
+// JSON-Pointer InstanceLocation for every violation, unlike Validate's
+// plain error or ValidateDocument's line/column Diagnostics.
+func ValidateSchema(data []byte) error {
+	var decoded interface{}
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	// jsonschema walks plain JSON values (map[string]interface{}, float64,
+	// ...); round-trip through encoding/json so YAML's native int/bool
+	// decoding doesn't trip it up.
+	asJSON, err := json.Marshal(decoded)
+	if err != nil {
+		return fmt.Errorf("failed to convert YAML to JSON for schema validation: %w", err)
+	}
+	var instance interface{}
+	if err := json.Unmarshal(asJSON, &instance); err != nil {
+		return fmt.Errorf("failed to decode JSON for schema validation: %w", err)
+	}
+
+	schema, err := compiledSchema()
+	if err != nil {
+		return fmt.Errorf("failed to compile JSON schema: %w", err)
+	}
+
+	if err := schema.Validate(instance); err != nil {
+		return fmt.Errorf("schema validation failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Package termcache caches lipgloss's terminal color-profile and
+// background detection across invocations. Profile detection itself is
+// cheap (it just reads $TERM/$COLORTERM), but background detection is a
+// genuine terminal round trip (an OSC query/response), which is
+// noticeable over SSH and adds up for anything that shells out to the
+// CLI repeatedly (CI, scripts). Apply caches that result keyed by the
+// terminal's identity so only the first run against a given terminal
+// pays for it.
+package termcache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
+	"github.com/muesli/termenv"
+)
+
+// entry is the on-disk cache record: the terminal identity it was
+// detected against, plus what was detected for it.
+type entry struct {
+	Term      string `json:"term"`
+	ColorTerm string `json:"color_term"`
+	SSHTTY    string `json:"ssh_tty"`
+	IsTTY     bool   `json:"is_tty"`
+	Profile   int    `json:"profile"`
+	Dark      bool   `json:"dark"`
+}
+
+// sameTerminal reports whether e and other were detected against the same
+// terminal identity, i.e. whether e's Profile/Dark are still valid for the
+// environment other was built from. IsTTY is part of that identity -
+// termenv.EnvColorProfile/HasDarkBackground both gate on stdout being a
+// terminal and fall back to no-color/light when it isn't, so a profile
+// cached from an earlier interactive run must never be replayed into a
+// later invocation whose stdout is piped or redirected, and vice versa.
+func (e entry) sameTerminal(other entry) bool {
+	return e.Term == other.Term && e.ColorTerm == other.ColorTerm && e.SSHTTY == other.SSHTTY && e.IsTTY == other.IsTTY
+}
+
+func currentIdentity() entry {
+	return entry{
+		Term:      os.Getenv("TERM"),
+		ColorTerm: os.Getenv("COLORTERM"),
+		SSHTTY:    os.Getenv("SSH_TTY"),
+		IsTTY:     isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()),
+	}
+}
+
+// cachePath returns $XDG_CACHE_HOME/fauxfoundry/term.json - os.UserCacheDir
+// already falls back to $HOME/.cache when XDG_CACHE_HOME is unset, the same
+// fallback this cache is meant to follow - or "" if neither can be
+// resolved.
+func cachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "fauxfoundry", "term.json")
+}
+
+// Apply forces lipgloss's default renderer to a known color profile and
+// background, so styled output never pays termenv's own detection more
+// than once per terminal. skipCache is the --no-term-cache escape hatch:
+// it always probes live and never reads or writes the cache file. Apply
+// must run before anything renders styled (lipgloss) output.
+func Apply(skipCache bool) {
+	current := currentIdentity()
+
+	if !skipCache {
+		if cached, ok := load(); ok && cached.sameTerminal(current) {
+			lipgloss.SetColorProfile(termenv.Profile(cached.Profile))
+			lipgloss.SetHasDarkBackground(cached.Dark)
+			return
+		}
+	}
+
+	current.Profile = int(termenv.EnvColorProfile())
+	current.Dark = termenv.HasDarkBackground()
+	lipgloss.SetColorProfile(termenv.Profile(current.Profile))
+	lipgloss.SetHasDarkBackground(current.Dark)
+
+	if !skipCache {
+		save(current)
+	}
+}
+
+// load reads the cache file, returning ok=false if it's missing or
+// unreadable - treated the same as a cold cache rather than an error,
+// since a stale or corrupt cache is always safe to just redetect.
+func load() (entry, bool) {
+	path := cachePath()
+	if path == "" {
+		return entry{}, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return entry{}, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return entry{}, false
+	}
+	return e, true
+}
+
+// save best-effort writes e to the cache file; a failure here just means
+// the next run redetects, so it's not surfaced as an error.
+func save(e entry) {
+	path := cachePath()
+	if path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+)
+
+// activityWatchMsg carries the result of starting MainView's activity.Watch
+// into Update: the existing backlog (oldest first) plus the live watcher,
+// or err if either step failed.
+type activityWatchMsg struct {
+	entries []activity.Entry
+	watcher *activity.Watcher
+	err     error
+}
+
+// activityEntryMsg carries one newly-appended activity.Entry into Update.
+type activityEntryMsg activity.Entry
+
+// startActivityWatch loads path's existing backlog and begins tailing it,
+// for Init to kick off once at startup.
+func startActivityWatch(path string) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := activity.Load(path)
+		if err != nil {
+			return activityWatchMsg{err: err}
+		}
+		watcher, err := activity.Watch(path)
+		if err != nil {
+			return activityWatchMsg{entries: entries, err: err}
+		}
+		return activityWatchMsg{entries: entries, watcher: watcher}
+	}
+}
+
+// listenForActivity returns a tea.Cmd that reads the next Entry off
+// watcher, re-issuing itself from Update until its Entries channel closes.
+func listenForActivity(watcher *activity.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		e, ok := <-watcher.Entries
+		if !ok {
+			return nil
+		}
+		return activityEntryMsg(e)
+	}
+}
+
+// relativeTime renders t the way the Recent Activity section does - "2m
+// ago" rather than a timestamp - falling back to RFC3339 for anything a
+// day or older, where a relative count stops being the more readable form.
+func relativeTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	d := time.Since(t)
+	switch {
+	case d < time.Second:
+		return "just now"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return t.Format(time.RFC3339)
+	}
+}
@@ -1,11 +1,21 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+	"github.com/copyleftdev/faux-foundry/internal/buildinfo"
+	"github.com/copyleftdev/faux-foundry/internal/cliconfig"
+	"github.com/copyleftdev/faux-foundry/internal/history"
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+	"github.com/copyleftdev/faux-foundry/internal/termcache"
 	"github.com/copyleftdev/faux-foundry/pkg/types"
 )
 
@@ -16,6 +26,20 @@ type AppState struct {
 	Settings        *UserSettings
 	History         []HistoryEntry
 	Notifications   []Notification
+
+	// Ollama is the last-known result of App's periodic health poll (see
+	// ollama.go), populated after App's first Init batch runs. A view
+	// should treat a nil Ollama as "not checked yet" rather than "down".
+	Ollama *OllamaStatus
+
+	// ScopedPath is the directory or single spec file `fauxfoundry tui
+	// <path>` (or --spec) was launched against, empty for an unscoped
+	// launch. ScopedSpecs is that path resolved to its sorted *.yaml/*.yml
+	// files (one entry for a single file), and ScopedIndex is which of
+	// them is currently loaded into CurrentSpec. See loadScopedSpec.
+	ScopedPath  string
+	ScopedSpecs []string
+	ScopedIndex int
 }
 
 // UserSettings represents user preferences
@@ -26,13 +50,22 @@ type UserSettings struct {
 	Confirmations   bool
 	DefaultBatchSize int
 	DefaultTimeout  string
+	// Provider selects the LLM backend by its provider.Register name
+	// (e.g. "ollama", "openai", "anthropic", "gemini").
+	Provider        string
 }
 
-// HistoryEntry represents a historical action
+// HistoryEntry represents a historical action. JobID/ParentID, when set,
+// mirror a recorded internal/history.Run: JobID is that run's ID, and
+// ParentID (empty for a run started from scratch) is the run it was
+// branched from, letting SpecBrowserView render the branch tree instead of
+// a flat list.
 type HistoryEntry struct {
 	Timestamp string
 	Action    string
 	Details   string
+	JobID     string
+	ParentID  string
 }
 
 // Notification represents a user notification
@@ -50,6 +83,18 @@ type App struct {
 	width      int
 	height     int
 	theme      *Theme
+
+	// ollama and ollamaEndpoint drive the periodic health poll started by
+	// Init and kept alive by Update's ollamaTickMsg case. MainView shares
+	// both so its "Refresh"/"Install Model" buttons can issue the same
+	// calls on demand.
+	ollama         *llm.OllamaClient
+	ollamaEndpoint string
+
+	// activity is the recorder MainView's "Recent Activity" section reads
+	// its backlog from (via activity.Load) and appends generation/model
+	// events to as they happen; see internal/activity.
+	activity *activity.Recorder
 }
 
 // ViewType represents different views in the application
@@ -75,6 +120,14 @@ type Theme struct {
 	Warning     lipgloss.Color
 	Error       lipgloss.Color
 	Info        lipgloss.Color
+
+	// LogoForeground/LogoBackground color the startup banner's ASCII logo
+	// (see MainView.renderBanner) one lipgloss.Color per row, cycling if
+	// the logo has more rows than colors. LogoBackground is usually left
+	// empty - a themed background per row is a louder look most users
+	// won't want by default.
+	LogoForeground []lipgloss.Color
+	LogoBackground []lipgloss.Color
 }
 
 // DefaultTheme returns the default dark theme
@@ -89,6 +142,13 @@ func DefaultTheme() *Theme {
 		Warning:     lipgloss.Color("#F59E0B"), // Amber
 		Error:       lipgloss.Color("#EF4444"), // Red
 		Info:        lipgloss.Color("#3B82F6"), // Blue
+		LogoForeground: []lipgloss.Color{
+			lipgloss.Color("#7C3AED"), // Purple
+			lipgloss.Color("#8B5CF6"),
+			lipgloss.Color("#A78BFA"),
+			lipgloss.Color("#F59E0B"), // Amber
+			lipgloss.Color("#FBBF24"),
+		},
 	}
 }
 
@@ -102,26 +162,46 @@ func NewApp(specFile string) *App {
 			Confirmations:   true,
 			DefaultBatchSize: 32,
 			DefaultTimeout:  "2h",
+			Provider:        "ollama",
 		},
 		History:       []HistoryEntry{},
 		Notifications: []Notification{},
 	}
 
-	// Load specification if provided
+	recorder := activity.NewRecorder("")
+
+	// Scope the dashboard to specFile if one was given, loading its first
+	// (or only) spec into CurrentSpec.
 	if specFile != "" {
-		// TODO: Load specification from file
-		state.Notifications = append(state.Notifications, Notification{
-			Type:    "info",
-			Message: fmt.Sprintf("Loaded specification: %s", specFile),
-			Time:    "now",
-		})
+		specs, err := resolveScopedSpecs(specFile)
+		if err != nil || len(specs) == 0 {
+			state.Notifications = append(state.Notifications, Notification{
+				Type:    "error",
+				Message: fmt.Sprintf("Failed to scope to %s: %v", specFile, err),
+				Time:    "now",
+			})
+		} else {
+			state.ScopedPath = specFile
+			state.ScopedSpecs = specs
+			loadScopedSpec(state, recorder, 0)
+		}
+	}
+
+	state.History = loadHistoryEntries()
+
+	endpoint := cliconfig.BuiltinEndpoint
+	if state.CurrentSpec != nil && state.CurrentSpec.Model.Endpoint != "" {
+		endpoint = state.CurrentSpec.Model.Endpoint
 	}
 
 	app := &App{
-		state:       state,
-		currentView: ViewMain,
-		views:       make(map[ViewType]tea.Model),
-		theme:       DefaultTheme(),
+		state:          state,
+		currentView:    ViewMain,
+		views:          make(map[ViewType]tea.Model),
+		theme:          DefaultTheme(),
+		ollama:         llm.NewOllamaClient(),
+		ollamaEndpoint: endpoint,
+		activity:       recorder,
 	}
 
 	// Initialize views
@@ -132,7 +212,7 @@ func NewApp(specFile string) *App {
 
 // initViews initializes all the views
 func (a *App) initViews() {
-	a.views[ViewMain] = NewMainView(a.state, a.theme)
+	a.views[ViewMain] = NewMainView(a.state, a.theme, a.ollama, a.ollamaEndpoint, a.activity)
 	a.views[ViewSpecEditor] = NewSpecEditorView(a.state, a.theme)
 	a.views[ViewSpecBrowser] = NewSpecBrowserView(a.state, a.theme)
 	a.views[ViewGenerationMonitor] = NewGenerationMonitorView(a.state, a.theme)
@@ -145,6 +225,8 @@ func (a *App) Init() tea.Cmd {
 	return tea.Batch(
 		tea.EnterAltScreen,
 		a.views[a.currentView].Init(),
+		pollOllama(a.ollama, a.ollamaEndpoint),
+		ollamaTick(),
 	)
 }
 
@@ -163,12 +245,26 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.views[viewType], _ = view.Update(msg)
 		}
 
+	case ollamaTickMsg:
+		return a, tea.Batch(pollOllama(a.ollama, a.ollamaEndpoint), ollamaTick())
+
+	case ollamaStatusMsg:
+		applyOllamaStatus(a.state, msg)
+		return a, nil
+
+	case ollamaModelsMsg:
+		applyOllamaModels(a.state, msg)
+		return a, nil
+
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q":
 			if a.currentView == ViewMain {
 				return a, tea.Quit
 			}
+			if monitor, ok := a.views[ViewGenerationMonitor].(*GenerationMonitorView); ok {
+				monitor.Cancel()
+			}
 			// Go back to main view from other views
 			a.currentView = ViewMain
 			return a, nil
@@ -182,9 +278,23 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, nil
 
 		case "f3":
-			// TODO: Start generation
-			a.currentView = ViewGenerationMonitor
-			return a, nil
+			mainView, ok := a.views[ViewMain].(*MainView)
+			if !ok || a.state.CurrentSpec == nil || a.state.ActiveGeneration != nil {
+				return a, nil
+			}
+			genCtx, genCancel := context.WithCancel(context.Background())
+			runner, genJob, err := startGeneration(genCtx, genCancel, a.state.CurrentSpec)
+			if err != nil {
+				genCancel()
+				a.state.Notifications = append(a.state.Notifications, Notification{
+					Type:    "error",
+					Message: fmt.Sprintf("Failed to start generation: %v", err),
+					Time:    "now",
+				})
+				return a, nil
+			}
+			a.state.ActiveGeneration = genJob
+			return a, mainView.AttachRunner(runner)
 
 		case "f4":
 			a.currentView = ViewGenerationMonitor
@@ -252,7 +362,11 @@ func (a *App) renderHeader() string {
 		Foreground(a.theme.Secondary).
 		Padding(0, 1)
 
-	title := titleStyle.Render("FauxFoundry v0.1.0")
+	titleText := fmt.Sprintf("FauxFoundry v%s", buildinfo.Version)
+	if a.state.ScopedPath != "" {
+		titleText = fmt.Sprintf("%s — %s", titleText, a.state.ScopedPath)
+	}
+	title := titleStyle.Render(titleText)
 	shortcuts := shortcutsStyle.Render("[F1] Help  [F2] Specs  [F3] Generate  [F4] Monitor  [F10] Quit")
 
 	headerStyle := lipgloss.NewStyle().
@@ -308,8 +422,113 @@ func (a *App) renderFooter() string {
 	)
 }
 
-// Run starts the TUI application
-func Run(specFile string) error {
+// resolveScopedSpecs turns path - a spec file or a directory of them - into
+// the sorted list of spec files MainView's n/p navigation cycles through,
+// mirroring cli's expandValidateArgs for directories.
+func resolveScopedSpecs(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(path, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	ymlMatches, err := filepath.Glob(filepath.Join(path, "*.yml"))
+	if err != nil {
+		return nil, err
+	}
+
+	all := append(matches, ymlMatches...)
+	sort.Strings(all)
+	return all, nil
+}
+
+// loadScopedSpec loads state.ScopedSpecs[index] into state.CurrentSpec,
+// recording the result through recorder the same way App's initial
+// spec-load notification always has. A bad index or a load failure leaves
+// state.CurrentSpec and ScopedIndex untouched other than a notification.
+func loadScopedSpec(state *AppState, recorder *activity.Recorder, index int) {
+	if index < 0 || index >= len(state.ScopedSpecs) {
+		return
+	}
+	path := state.ScopedSpecs[index]
+
+	loader := spec.NewLoader(filepath.Dir(path))
+	doc, err := loader.Load(path)
+	if err != nil {
+		state.Notifications = append(state.Notifications, Notification{
+			Type:    "error",
+			Message: fmt.Sprintf("Failed to load %s: %v", path, err),
+			Time:    "now",
+		})
+		_ = recorder.Record("spec_load_failed", fmt.Sprintf("%s: %v", path, err))
+		return
+	}
+
+	state.ScopedIndex = index
+	state.CurrentSpec = doc.Spec
+	state.Notifications = append(state.Notifications, Notification{
+		Type:    "info",
+		Message: fmt.Sprintf("Loaded specification: %s", path),
+		Time:    "now",
+	})
+	_ = recorder.Record("spec_loaded", path)
+}
+
+// loadHistoryEntries reads every recorded internal/history.Run from the
+// default history store (the same $HOME/.fauxfoundry/history.db path
+// internal/cli's "history" subcommand falls back to) and converts them to
+// HistoryEntry values for SpecBrowserView to render as a branch tree. A
+// missing or unopenable store just means no runs yet, so it returns nil
+// rather than surfacing an error — the TUI has no Stderr to log it to.
+func loadHistoryEntries() []HistoryEntry {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	store, err := history.Open(filepath.Join(home, ".fauxfoundry", "history.db"))
+	if err != nil {
+		return nil
+	}
+	defer store.Close()
+
+	runs, err := store.List()
+	if err != nil {
+		return nil
+	}
+
+	entries := make([]HistoryEntry, 0, len(runs))
+	for _, run := range runs {
+		entries = append(entries, HistoryEntry{
+			Timestamp: run.CreatedAt.Format("2006-01-02 15:04"),
+			Action:    "generate",
+			Details:   fmt.Sprintf("%s (%s)", run.Spec.Dataset.Domain, run.Status),
+			JobID:     run.JobID,
+			ParentID:  run.ParentID,
+		})
+	}
+	return entries
+}
+
+// Run starts the TUI application. noTermCache skips internal/termcache's
+// cached color-profile/background detection (the --no-term-cache flag),
+// forcing a live probe instead - the TUI is the heaviest lipgloss user in
+// the codebase, so this is where a stale cache would be most visible. If
+// shouldRenderPlain(plain) reports true, it prints a stripped ASCII
+// dashboard via RunPlain instead of launching the interactive program.
+func Run(specFile string, noTermCache, plain bool) error {
+	if shouldRenderPlain(plain) {
+		return RunPlain(specFile)
+	}
+
+	termcache.Apply(noTermCache)
+
 	app := NewApp(specFile)
 	
 	p := tea.NewProgram(
@@ -0,0 +1,338 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/copyleftdev/faux-foundry/internal/job"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// recordPreviewLimit bounds how many of the most recently written records
+// GenerationMonitorView keeps around to render in its preview pane.
+const recordPreviewLimit = 5
+
+// rateHistoryLimit bounds the rolling records/sec samples kept for the
+// sparkline, so a long-running job's sparkline reflects recent throughput
+// rather than the whole run.
+const rateHistoryLimit = 40
+
+// sparkBlocks are the unicode block characters used to render rateHistory,
+// lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// monitorUpdateMsg carries a job.Snapshot into the Bubble Tea update loop.
+type monitorUpdateMsg job.Snapshot
+
+// monitorRecordMsg carries a newly written record into the update loop, for
+// the preview pane.
+type monitorRecordMsg types.Record
+
+// monitorDoneMsg signals that controller.Run has returned.
+type monitorDoneMsg struct{ err error }
+
+// GenerationMonitorView shows a live view of a generation job: a progress
+// bar, a rolling records/sec sparkline, the current dedup ratio and batch
+// size, a preview of the most recently written records, and hotkeys to
+// pause/resume the job or adjust its retry config on the fly. It renders
+// nothing but a placeholder until AttachController wires it to a running
+// job.Controller - the F4 view built by initViews stays in that state,
+// since nothing ever attaches a controller to it; RunGenerationMonitor
+// builds a dedicated, attached instance instead.
+type GenerationMonitorView struct {
+	state  *AppState
+	theme  *Theme
+	width  int
+	height int
+
+	controller *job.Controller
+	ctx        context.Context
+	cancel     context.CancelFunc
+
+	bar         progress.Model
+	latest      job.Snapshot
+	rateHistory []float64
+	records     []types.Record
+	maxRetries  int
+	minBatch    int
+	done        bool
+	err         error
+}
+
+func NewGenerationMonitorView(state *AppState, theme *Theme) *GenerationMonitorView {
+	return &GenerationMonitorView{
+		state: state,
+		theme: theme,
+		bar:   progress.New(progress.WithDefaultGradient()),
+	}
+}
+
+// AttachController wires v to controller, whose Updates/Records it will
+// drain once Init runs. cancel is called by Cancel() and by the "c"
+// hotkey, stopping the job's context.
+func (v *GenerationMonitorView) AttachController(controller *job.Controller, cancel context.CancelFunc) {
+	v.controller = controller
+	v.cancel = cancel
+	if cfg := controller.RetryConfig(); cfg != nil {
+		v.maxRetries = cfg.MaxRetries
+		v.minBatch = cfg.MinBatchSize
+	}
+}
+
+func (v *GenerationMonitorView) Init() tea.Cmd {
+	if v.controller == nil {
+		return nil
+	}
+	return tea.Batch(
+		listenForUpdate(v.controller.Updates),
+		listenForRecord(v.controller.Records),
+		runController(v.ctx, v.controller),
+	)
+}
+
+// listenForUpdate returns a tea.Cmd that reads the next Snapshot off
+// updates, re-issuing itself from Update until the channel closes.
+func listenForUpdate(updates <-chan job.Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		snap, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return monitorUpdateMsg(snap)
+	}
+}
+
+// listenForRecord returns a tea.Cmd that reads the next record off
+// records, re-issuing itself from Update until the channel closes.
+func listenForRecord(records <-chan types.Record) tea.Cmd {
+	return func() tea.Msg {
+		record, ok := <-records
+		if !ok {
+			return nil
+		}
+		return monitorRecordMsg(record)
+	}
+}
+
+// runController drives controller.Run to completion in the background
+// under ctx (context.Background when nil), so cancelling ctx stops the job.
+func runController(ctx context.Context, controller *job.Controller) tea.Cmd {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return func() tea.Msg {
+		err := controller.Run(ctx)
+		return monitorDoneMsg{err: err}
+	}
+}
+
+func (v *GenerationMonitorView) Cancel() {
+	if v.cancel != nil {
+		v.cancel()
+	}
+}
+
+func (v *GenerationMonitorView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.width = msg.Width
+		v.height = msg.Height - 4
+		v.bar.Width = v.width - 8
+
+	case tea.KeyMsg:
+		if v.controller == nil {
+			break
+		}
+		switch msg.String() {
+		case " ":
+			v.controller.TogglePause()
+		case "c":
+			v.Cancel()
+		case "+":
+			v.maxRetries++
+			v.applyRetryConfig()
+		case "-":
+			if v.maxRetries > 0 {
+				v.maxRetries--
+			}
+			v.applyRetryConfig()
+		case "]":
+			v.minBatch++
+			v.applyRetryConfig()
+		case "[":
+			if v.minBatch > 1 {
+				v.minBatch--
+			}
+			v.applyRetryConfig()
+		}
+
+	case monitorUpdateMsg:
+		v.latest = job.Snapshot(msg)
+		if v.latest.Phase == job.PhaseBatchCompleted {
+			v.rateHistory = append(v.rateHistory, v.latest.Progress.Rate)
+			if len(v.rateHistory) > rateHistoryLimit {
+				v.rateHistory = v.rateHistory[len(v.rateHistory)-rateHistoryLimit:]
+			}
+		}
+		return v, listenForUpdate(v.controller.Updates)
+
+	case monitorRecordMsg:
+		v.records = append(v.records, types.Record(msg))
+		if len(v.records) > recordPreviewLimit {
+			v.records = v.records[len(v.records)-recordPreviewLimit:]
+		}
+		return v, listenForRecord(v.controller.Records)
+
+	case monitorDoneMsg:
+		v.done = true
+		v.err = msg.err
+		return v, nil
+	}
+
+	return v, nil
+}
+
+// applyRetryConfig pushes v's maxRetries/minBatch onto the controller,
+// cloning its current config so unrelated fields (BaseTimeout,
+// BackoffMultiplier, ...) are preserved.
+func (v *GenerationMonitorView) applyRetryConfig() {
+	cfg := *v.controller.RetryConfig()
+	cfg.MaxRetries = v.maxRetries
+	cfg.MinBatchSize = v.minBatch
+	v.controller.SetRetryConfig(&cfg)
+}
+
+func (v *GenerationMonitorView) View() string {
+	style := lipgloss.NewStyle().
+		Width(v.width).
+		Height(v.height).
+		Padding(2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Success)
+
+	title := lipgloss.NewStyle().
+		Foreground(v.theme.Success).
+		Bold(true).
+		Render("📊 Generation Monitor")
+
+	var body string
+	switch {
+	case v.controller == nil:
+		body = "Real-time progress tracking\n" +
+			"• Live progress bars and statistics\n" +
+			"• Record preview and validation\n" +
+			"• Performance metrics\n\n" +
+			lipgloss.NewStyle().
+				Foreground(v.theme.Secondary).
+				Render("Start a generation with --interactive to see live progress here.")
+	case v.err != nil:
+		body = lipgloss.NewStyle().Foreground(v.theme.Error).Render(fmt.Sprintf("Generation failed: %v", v.err))
+	case v.done:
+		body = lipgloss.NewStyle().Foreground(v.theme.Success).Render("✅ Generation completed.")
+	default:
+		body = v.renderProgress()
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, title, "", body))
+}
+
+func (v *GenerationMonitorView) renderProgress() string {
+	p := v.latest.Progress
+	var pct float64
+	if p.Target > 0 {
+		pct = float64(p.Generated) / float64(p.Target)
+	}
+
+	lines := []string{
+		v.bar.ViewAs(pct),
+		fmt.Sprintf("%d/%d records  |  batch size %d  |  %s", p.Generated, p.Target, p.BatchSize, v.latest.Dedup.String()),
+		fmt.Sprintf("rate: %.2f rec/s   elapsed: %s   eta: %s", p.Rate, p.ElapsedTime, p.EstimatedETA),
+		fmt.Sprintf("throughput: %s", renderSparkline(v.rateHistory)),
+	}
+
+	if v.controller.Paused() {
+		lines = append(lines, lipgloss.NewStyle().Foreground(v.theme.Warning).Render("⏸ paused"))
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Foreground(v.theme.Secondary).Render(
+		fmt.Sprintf("max-retries: %d   min-batch-size: %d", v.maxRetries, v.minBatch)))
+
+	lines = append(lines, lipgloss.NewStyle().Foreground(v.theme.Secondary).Render(
+		"[space] pause/resume  [+/-] max-retries  [[/]] min-batch-size  [c] cancel"))
+
+	if len(v.records) > 0 {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(v.theme.Info).Bold(true).Render("Recent records:"))
+		for _, record := range v.records {
+			encoded, err := json.Marshal(record)
+			if err != nil {
+				continue
+			}
+			lines = append(lines, lipgloss.NewStyle().Foreground(v.theme.Secondary).Render(string(encoded)))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderSparkline draws rates as a single line of unicode block characters,
+// scaled so the highest sample in rates renders as a full block.
+func renderSparkline(rates []float64) string {
+	if len(rates) == 0 {
+		return "(warming up)"
+	}
+
+	max := rates[0]
+	for _, r := range rates {
+		if r > max {
+			max = r
+		}
+	}
+	if max == 0 {
+		max = 1
+	}
+
+	var b strings.Builder
+	for _, r := range rates {
+		idx := int(r / max * float64(len(sparkBlocks)-1))
+		if idx < 0 {
+			idx = 0
+		}
+		if idx >= len(sparkBlocks) {
+			idx = len(sparkBlocks) - 1
+		}
+		b.WriteRune(sparkBlocks[idx])
+	}
+	return b.String()
+}
+
+// RunGenerationMonitor launches a standalone Bubble Tea program showing
+// controller's live progress, for `fauxfoundry generate --interactive`.
+// Unlike tui.Run, it skips the full App shell (spec browser, settings,
+// etc.) and drives just the monitor view, since --interactive is scoped to
+// watching a single job that's already been built and validated by the
+// CLI. cancel is wired to the "c" hotkey and to the program exiting early.
+func RunGenerationMonitor(ctx context.Context, cancel context.CancelFunc, controller *job.Controller) error {
+	view := NewGenerationMonitorView(&AppState{}, DefaultTheme())
+	view.AttachController(controller, cancel)
+	view.ctx = ctx
+
+	p := tea.NewProgram(view, tea.WithAltScreen())
+	_, err := p.Run()
+	if cancel != nil {
+		cancel()
+	}
+	if err != nil {
+		return fmt.Errorf("interactive monitor failed: %w", err)
+	}
+
+	if view.err != nil {
+		return view.err
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package tui
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/copyleftdev/faux-foundry/internal/job"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// genTickMsg drives MainView's periodic redraw of its per-worker bars
+// while a GenerationRunner is attached; see genTickInterval.
+type genTickMsg struct{}
+
+// genTick schedules the next genTickMsg.
+func genTick() tea.Cmd {
+	return tea.Tick(genTickInterval, func(time.Time) tea.Msg { return genTickMsg{} })
+}
+
+// genDoneMsg signals that a GenerationRunner's doneChannel has closed,
+// carrying the job's error if it failed.
+type genDoneMsg struct{ err error }
+
+// listenForGenDone waits for runner's job to finish, returning a genDoneMsg
+// with whatever error (if any) arrived on its errorChannel.
+func listenForGenDone(runner *GenerationRunner) tea.Cmd {
+	return func() tea.Msg {
+		<-runner.doneChannel
+		select {
+		case err := <-runner.errorChannel:
+			return genDoneMsg{err: err}
+		default:
+			return genDoneMsg{}
+		}
+	}
+}
+
+// GenericProgress is a count towards a total, independent of what's being
+// counted - a single worker's current batch, or the aggregate across every
+// worker's.
+type GenericProgress struct {
+	Current int
+	Total   int
+}
+
+// generationUpdate attributes one job.Snapshot to the worker.Pool worker
+// that generated it, carrying that worker's running progress through its
+// current batch.
+type generationUpdate struct {
+	WorkerID int
+	Progress GenericProgress
+}
+
+// GenerationRunner drives a job.Controller in the background (similar to
+// ficsit-cli's threaded download pooling) and fans its per-record Updates
+// out across updateChannel/doneChannel/errorChannel, attributed per
+// worker, so MainView can render a stacked progress.Model per worker plus
+// an aggregate bar by polling Snapshot on a tea.Tick instead of reacting to
+// every update - a job.Controller with several workers in flight can emit
+// far more PhaseRecordWritten events per second than the TUI needs to
+// redraw for. cancelChannel lets "Cancel" request a stop without MainView
+// reaching into the controller directly; "Pause" goes through TogglePause.
+type GenerationRunner struct {
+	controller *job.Controller
+	cancel     context.CancelFunc
+	workers    int
+
+	updateChannel chan generationUpdate
+	doneChannel   chan struct{}
+	errorChannel  chan error
+	cancelChannel chan struct{}
+
+	mu       sync.Mutex
+	progress map[int]GenericProgress
+}
+
+// NewGenerationRunner wraps controller, whose job context cancel is the
+// same CancelFunc GenerationMonitorView's AttachController would otherwise
+// hold, reporting workers concurrent generation slots.
+func NewGenerationRunner(controller *job.Controller, cancel context.CancelFunc, workers int) *GenerationRunner {
+	if workers < 1 {
+		workers = 1
+	}
+	return &GenerationRunner{
+		controller:    controller,
+		cancel:        cancel,
+		workers:       workers,
+		updateChannel: make(chan generationUpdate, 64),
+		doneChannel:   make(chan struct{}),
+		errorChannel:  make(chan error, 1),
+		cancelChannel: make(chan struct{}),
+		progress:      make(map[int]GenericProgress, workers),
+	}
+}
+
+// Workers returns how many concurrent generation slots r is driving.
+func (r *GenerationRunner) Workers() int { return r.workers }
+
+// Job returns the GenerationJob r is driving, for a caller (the health
+// strip's genDoneMsg handler) that needs its spec/model/progress once Run
+// has finished.
+func (r *GenerationRunner) Job() *types.GenerationJob {
+	return r.controller.Job
+}
+
+// Close releases r's underlying Controller (its output writer and events
+// bus), the same cleanup cli's runGeneration/runInteractiveGeneration do
+// via `defer controller.Close()`. Call it once doneChannel has closed.
+func (r *GenerationRunner) Close() error {
+	return r.controller.Close()
+}
+
+// Start launches controller.Run plus the goroutines that drain its Updates
+// into r's per-worker progress and watch cancelChannel. Call it once,
+// before reading Snapshot or waiting on doneChannel/errorChannel.
+func (r *GenerationRunner) Start(ctx context.Context) {
+	batchStart := make(map[int]int) // WorkerID -> records written so far in its current batch
+
+	// Records has no preview consumer here (unlike GenerationMonitorView),
+	// but it must still be drained - Controller.send's select/default
+	// guards Updates against a slow reader, but Controller.Run's
+	// c.Records <- record send is unbuffered-equivalent once its buffer
+	// fills, so an undrained Records would eventually stall generation.
+	go func() {
+		for range r.controller.Records {
+		}
+	}()
+
+	go func() {
+		for snap := range r.controller.Updates {
+			if snap.Phase == job.PhaseBatchStarted {
+				batchStart[snap.WorkerID] = 0
+			} else if snap.Phase == job.PhaseRecordWritten {
+				batchStart[snap.WorkerID]++
+			}
+
+			update := generationUpdate{
+				WorkerID: snap.WorkerID,
+				Progress: GenericProgress{Current: batchStart[snap.WorkerID], Total: snap.BatchSize},
+			}
+
+			r.mu.Lock()
+			r.progress[update.WorkerID] = update.Progress
+			r.mu.Unlock()
+
+			select {
+			case r.updateChannel <- update:
+			default:
+			}
+		}
+	}()
+
+	// Also selects on doneChannel, not just cancelChannel: a run that
+	// finishes on its own (success or error) never sends on
+	// cancelChannel, and nothing else closes it, so without this the
+	// goroutine would block forever and leak once per generation run in
+	// this long-running TUI process.
+	go func() {
+		select {
+		case <-r.cancelChannel:
+			r.cancel()
+		case <-r.doneChannel:
+		}
+	}()
+
+	go func() {
+		err := r.controller.Run(ctx)
+		if err != nil {
+			select {
+			case r.errorChannel <- err:
+			default:
+			}
+		}
+		close(r.doneChannel)
+	}()
+}
+
+// Snapshot returns each active worker's last-known GenericProgress and the
+// aggregate across all of them (the overall job Progress, via
+// r.controller.Job.Progress), for a periodic tea.Tick to render.
+func (r *GenerationRunner) Snapshot() (perWorker map[int]GenericProgress, aggregate GenericProgress) {
+	r.mu.Lock()
+	perWorker = make(map[int]GenericProgress, len(r.progress))
+	for id, p := range r.progress {
+		perWorker[id] = p
+	}
+	r.mu.Unlock()
+
+	progress := r.controller.Job.Progress
+	return perWorker, GenericProgress{Current: progress.Generated, Total: progress.Target}
+}
+
+// TogglePause pauses or resumes the underlying controller's batch loop
+// (see job.Controller.TogglePause) and reports the state after flipping.
+func (r *GenerationRunner) TogglePause() bool {
+	return r.controller.TogglePause()
+}
+
+// Cancel requests the job stop, via cancelChannel rather than calling the
+// controller's CancelFunc directly, so MainView doesn't need to hold onto
+// it itself. The controller's own runCtx cancellation (see
+// job.Controller.Run) is what drains every in-flight worker cleanly; this
+// only triggers it.
+func (r *GenerationRunner) Cancel() {
+	select {
+	case r.cancelChannel <- struct{}{}:
+	default:
+	}
+}
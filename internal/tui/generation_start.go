@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/copyleftdev/faux-foundry/internal/agents"
+	"github.com/copyleftdev/faux-foundry/internal/dedup"
+	"github.com/copyleftdev/faux-foundry/internal/events"
+	"github.com/copyleftdev/faux-foundry/internal/job"
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/output"
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// nonFilenameChars is everything outputPathFor strips out of a spec's
+// Domain to build a safe *.jsonl filename from it.
+var nonFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// outputPathFor derives a default output filename from spec's domain, for
+// F3's "just generate what's loaded" path, which (unlike `fauxfoundry
+// generate --output`) has no flag to read one from. "stdout" is never used
+// here - a Bubble Tea program owns the terminal while Start runs.
+func outputPathFor(spec *types.Specification) string {
+	name := strings.Trim(nonFilenameChars.ReplaceAllString(spec.Dataset.Domain, "-"), "-")
+	if name == "" {
+		name = "generated"
+	}
+	return fmt.Sprintf("%s.jsonl", strings.ToLower(name))
+}
+
+// startGeneration builds the same job.Controller cli's prepareController
+// does for spec - output writer, deduplicator, field-tool executor, events
+// bus, backend health check - then wraps it in a GenerationRunner and
+// starts it, for the System Status section (via MainView.AttachRunner) to
+// render. Controller/provider logging goes to io.Discard rather than the
+// terminal, since the Bubble Tea program owns the screen while this runs.
+func startGeneration(ctx context.Context, cancel context.CancelFunc, spec *types.Specification) (*GenerationRunner, *types.GenerationJob, error) {
+	genJob := &types.GenerationJob{
+		ID:         fmt.Sprintf("job_%d", time.Now().UnixNano()),
+		Spec:       *spec,
+		Status:     types.JobStatusPending,
+		StartTime:  time.Now(),
+		OutputPath: outputPathFor(spec),
+		Progress:   types.Progress{Target: spec.Dataset.Count},
+	}
+
+	logger := log.New(log.FormatConsole, slog.LevelError, io.Discard)
+
+	client := llm.NewProviderClient()
+	client.Logger = logger
+
+	health, err := client.CheckModelHealth(ctx, spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check backend health: %w", err)
+	}
+	if !health.IsRunning {
+		return nil, nil, fmt.Errorf("backend not available: %s", health.ErrorMessage)
+	}
+
+	writer, err := output.NewStreamingWriter(genJob.OutputPath, 100)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create output writer: %w", err)
+	}
+
+	deduplicator := dedup.NewBatchDeduplicator(spec.Model.BatchSize)
+	agentExecutor := agents.NewExecutor(logger, "")
+	bus := events.NewBus(nil, genJob.ID, nil)
+
+	workers := spec.Model.Concurrency
+	controller := job.NewController(client, writer, deduplicator, agentExecutor, bus, logger, genJob, llm.DefaultRetryConfig(), workers)
+
+	runner := NewGenerationRunner(controller, cancel, workers)
+	runner.Start(ctx)
+
+	return runner, genJob, nil
+}
@@ -0,0 +1,203 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// generationRunAction is the activity.Entry.Action a finished run is
+// recorded under, distinct from the human-readable "generation_finished"/
+// "generation_failed" lines Recent Activity already shows - its Detail is
+// a RunRecord encoded as JSON rather than prose, for the health strip to
+// decode back out of the same persisted log.
+const generationRunAction = "generation_run"
+
+// RunOutcome classifies a finished generation run for the health strip's
+// glyph and color.
+type RunOutcome string
+
+const (
+	RunSuccess   RunOutcome = "success"   // hit its target with no error
+	RunPartial   RunOutcome = "partial"   // stopped short of target, no error
+	RunCancelled RunOutcome = "cancelled" // stopped by Cancel (ctx.Canceled)
+	RunFailed    RunOutcome = "failed"    // stopped by a real error
+)
+
+// RunRecord is what the health strip persists per finished run - enough to
+// render both its glyph and the detail panel a selected glyph pops open,
+// without re-reading the spec file or job store.
+type RunRecord struct {
+	Spec      string     `json:"spec"`
+	Model     string     `json:"model"`
+	Generated int        `json:"generated"`
+	Target    int        `json:"target"`
+	Duration  string     `json:"duration"`
+	Error     string     `json:"error,omitempty"`
+	Outcome   RunOutcome `json:"outcome"`
+}
+
+// newRunRecord classifies job's finished state (as genDoneMsg saw it) into
+// a RunRecord: a nil err with Generated == Target is RunSuccess, a nil err
+// short of it is RunPartial, an err wrapping context.Canceled (Cancel was
+// pressed) is RunCancelled, and any other err is RunFailed.
+func newRunRecord(job *types.GenerationJob, err error) RunRecord {
+	rec := RunRecord{
+		Spec:      job.Spec.Dataset.Domain,
+		Model:     job.Spec.Model.Name,
+		Generated: job.Progress.Generated,
+		Target:    job.Progress.Target,
+		Duration:  job.Progress.ElapsedTime,
+	}
+	switch {
+	case err == nil && rec.Generated >= rec.Target:
+		rec.Outcome = RunSuccess
+	case err == nil:
+		rec.Outcome = RunPartial
+	case errors.Is(err, context.Canceled):
+		rec.Outcome = RunCancelled
+	default:
+		rec.Outcome = RunFailed
+		rec.Error = err.Error()
+	}
+	return rec
+}
+
+// recordRunOutcome persists job's outcome through recorder, the same
+// activity-log store Recent Activity reads, so the health strip survives a
+// TUI restart. Failures are swallowed the same way every other recorder
+// call in this package treats them - worth nothing more than the
+// already-posted Notification.
+func recordRunOutcome(recorder *activity.Recorder, job *types.GenerationJob, err error) {
+	rec := newRunRecord(job, err)
+	data, marshalErr := json.Marshal(rec)
+	if marshalErr != nil {
+		return
+	}
+	_ = recorder.Record(generationRunAction, string(data))
+}
+
+// runRecordsFromEntries decodes every generationRunAction entry in
+// entries back into a RunRecord, oldest first, skipping any that fail to
+// decode (a torn or pre-health-strip log line).
+func runRecordsFromEntries(entries []activity.Entry) []RunRecord {
+	var runs []RunRecord
+	for _, e := range entries {
+		if e.Action != generationRunAction {
+			continue
+		}
+		var rec RunRecord
+		if err := json.Unmarshal([]byte(e.Detail), &rec); err != nil {
+			continue
+		}
+		runs = append(runs, rec)
+	}
+	return runs
+}
+
+// healthStripGlyph is the strip character for outcome: ✓ green for
+// RunSuccess, ~ yellow for RunPartial, - gray for RunCancelled, and x red
+// for RunFailed.
+func healthStripGlyph(outcome RunOutcome) string {
+	switch outcome {
+	case RunSuccess:
+		return "✓"
+	case RunPartial:
+		return "~"
+	case RunCancelled:
+		return "-"
+	default:
+		return "x"
+	}
+}
+
+// healthStripColor picks theme's color for outcome, mirroring how
+// Notification.Type already maps onto Success/Warning/Error/Secondary
+// elsewhere in this package.
+func (m *MainView) healthStripColor(outcome RunOutcome) lipgloss.Color {
+	switch outcome {
+	case RunSuccess:
+		return m.theme.Success
+	case RunPartial:
+		return m.theme.Warning
+	case RunCancelled:
+		return m.theme.Secondary
+	default:
+		return m.theme.Error
+	}
+}
+
+// HealthSectionData is the recent-runs health strip's content: every
+// RunRecord found in the activity log, oldest first, and which of them (if
+// any) [h/l] has selected for the detail panel.
+type HealthSectionData struct {
+	Runs     []RunRecord
+	Selected int // index into Runs, or -1 if none is selected
+}
+
+// healthSectionData builds HealthSectionData from m.entries, clamping
+// m.healthSelected back into range if the log shrank out from under it
+// (it never does in practice, but AttachRunner's own recorder and another
+// fauxfoundry invocation could race on it in principle).
+func (m *MainView) healthSectionData() HealthSectionData {
+	runs := runRecordsFromEntries(m.entries)
+	selected := m.healthSelected
+	if selected >= len(runs) {
+		selected = len(runs) - 1
+	}
+	return HealthSectionData{Runs: runs, Selected: selected}
+}
+
+// selectHealthRun moves m.healthSelected by delta, wrapping within
+// len(runs); called from Update's "h"/"l" cases once runs is non-empty. A
+// first press with nothing selected yet lands on the most recent run.
+func (m *MainView) selectHealthRun(delta int, total int) {
+	if total == 0 {
+		return
+	}
+	if m.healthSelected < 0 {
+		m.healthSelected = total - 1
+		return
+	}
+	m.healthSelected = ((m.healthSelected+delta)%total + total) % total
+}
+
+// renderHealthStrip renders data's glyphs left to right (oldest first),
+// colored by outcome, plus the detail panel for data.Selected if one is
+// set.
+func (m *MainView) renderHealthStrip(data HealthSectionData) string {
+	if len(data.Runs) == 0 {
+		return ""
+	}
+
+	glyphs := make([]string, len(data.Runs))
+	for i, run := range data.Runs {
+		style := lipgloss.NewStyle().Foreground(m.healthStripColor(run.Outcome))
+		if i == data.Selected {
+			style = style.Bold(true).Underline(true)
+		}
+		glyphs[i] = style.Render(healthStripGlyph(run.Outcome))
+	}
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Secondary)
+	strip := lipgloss.JoinHorizontal(lipgloss.Left,
+		strings.Join(glyphs, " "), "  ", hintStyle.Render("[h/l] select run"))
+
+	if data.Selected < 0 || data.Selected >= len(data.Runs) {
+		return strip
+	}
+
+	run := data.Runs[data.Selected]
+	detail := fmt.Sprintf("%s · %s · %d/%d records · %s",
+		run.Spec, run.Model, run.Generated, run.Target, run.Duration)
+	if run.Error != "" {
+		detail = fmt.Sprintf("%s · %s", detail, run.Error)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, strip, hintStyle.Render(detail))
+}
@@ -2,30 +2,128 @@ package tui
 
 import (
 	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+	"github.com/copyleftdev/faux-foundry/internal/buildinfo"
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/internal/spec"
 )
 
+// activityViewportHeight is how many lines of "Recent Activity" are
+// visible at once; j/k scroll the rest of the persisted log into view.
+const activityViewportHeight = 6
+
+// logoRows is the startup banner's "FAUXFOUNDRY" ASCII logo, one string
+// per row; renderBanner colors each row from Theme.LogoForeground,
+// cycling through it if there are more rows than colors.
+var logoRows = []string{
+	`█████  ███  █   █ █   █ █████  ███  █   █ █   █ ████  ████  █   █`,
+	`█     █   █ █   █  █ █  █     █   █ █   █ ██  █ █   █ █   █  █ █ `,
+	`███   █████ █   █   █   ███   █   █ █   █ █ █ █ █   █ ████    █  `,
+	`█     █   █ █   █  █ █  █     █   █ █   █ █  ██ █   █ █  █    █  `,
+	`█     █   █  ███  █   █ █      ███   ███  █   █ ████  █   █   █  `,
+}
+
+// minBannerHeight is how tall the terminal needs to be before View() shows
+// the banner at all - below it, the banner would crowd out the sections
+// that actually matter.
+const minBannerHeight = 24
+
+// genTickInterval is how often MainView redraws its per-worker generation
+// bars. A job.Controller running several workers can emit far more
+// PhaseRecordWritten snapshots per second than this - GenerationRunner
+// absorbs those into its latest-per-worker state, and this tick is what
+// actually pulls a fresh Snapshot for rendering.
+const genTickInterval = 250 * time.Millisecond
+
 // MainView represents the main dashboard view
 type MainView struct {
 	state  *AppState
 	theme  *Theme
 	width  int
 	height int
+
+	// client/endpoint let the "Refresh" and "Install Model" buttons issue
+	// the same Ollama calls App's periodic poll does, writing their
+	// results into the same shared AppState.Ollama.
+	client   *llm.OllamaClient
+	endpoint string
+
+	// pull is non-nil while an "Install Model" request is in flight or
+	// showing its result; View renders it as a modal in place of the
+	// normal dashboard until it's dismissed.
+	pull *pullModelState
+
+	// runner is non-nil while a generation job is active, attached via
+	// AttachRunner. The System Status section reads its Snapshot on every
+	// genTickMsg to render a stacked progress.Model per worker plus an
+	// aggregate bar.
+	runner *GenerationRunner
+
+	// recorder is what AttachRunner, the install-model flow, and App's
+	// spec-load notification append activity.Entry lines through.
+	recorder *activity.Recorder
+	// watcher tails recorder.Path live, so another fauxfoundry invocation
+	// appending to the same log shows up here without restarting the TUI.
+	watcher *activity.Watcher
+	// entries is the full activity backlog plus anything watcher has seen
+	// since, oldest first - activityViewport is what limits how much of it
+	// is visible at once.
+	entries []activity.Entry
+
+	// activityViewport renders entries scrollably ([j]/[k]) in place of
+	// the old hardcoded 3-row truncation.
+	activityViewport viewport.Model
+
+	// editor is non-nil while the "Edit" button's huh.Form is open,
+	// replacing the dashboard the same way pull does for installs. See
+	// spec_form.go.
+	editor *specEditorState
+
+	// healthSelected is the index into healthSectionData().Runs [h/l]
+	// moves to show a detail panel for, -1 until the user picks one (the
+	// strip itself always renders). See health_strip.go.
+	healthSelected int
+}
+
+// pullModelState tracks a single in-flight (or just-finished) /api/pull
+// request started from the "Install Model" button.
+type pullModelState struct {
+	model     string
+	bar       progress.Model
+	status    string
+	completed int64
+	total     int64
+	progress  <-chan llm.PullProgress
+	done      bool
+	err       error
 }
 
 // NewMainView creates a new main view
-func NewMainView(state *AppState, theme *Theme) *MainView {
+func NewMainView(state *AppState, theme *Theme, client *llm.OllamaClient, endpoint string, recorder *activity.Recorder) *MainView {
 	return &MainView{
-		state: state,
-		theme: theme,
+		state:            state,
+		theme:            theme,
+		client:           client,
+		endpoint:         endpoint,
+		recorder:         recorder,
+		activityViewport: viewport.New(80, activityViewportHeight),
+		healthSelected:   -1,
 	}
 }
 
 // Init implements tea.Model
 func (m *MainView) Init() tea.Cmd {
-	return nil
+	return startActivityWatch(m.recorder.Path)
 }
 
 // Update implements tea.Model
@@ -34,16 +132,269 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height - 4 // Account for header and footer
+		if m.pull != nil {
+			m.pull.bar.Width = m.width - 8
+		}
+		m.activityViewport.Width = m.width - 10
+		m.activityViewport.Height = activityViewportHeight
+
+	case tea.KeyMsg:
+		if m.editor != nil {
+			return m, m.routeToEditor(msg)
+		}
+		if m.pull != nil {
+			return m, m.updatePull(msg)
+		}
+		switch msg.String() {
+		case "r":
+			return m, pollOllama(m.client, m.endpoint)
+		case "i":
+			if m.state.Ollama != nil && m.state.Ollama.Running && len(m.state.Ollama.Models) == 0 {
+				return m, m.startInstall(llm.GetRecommendedModels()[0].Name)
+			}
+		case "g":
+			if m.state.Ollama == nil || !m.state.Ollama.Running {
+				m.state.Notifications = append(m.state.Notifications, Notification{
+					Type:    "info",
+					Message: llm.GetOllamaInstallInstructions()[installGuideKey()],
+					Time:    "now",
+				})
+			}
+		case "p":
+			switch {
+			case m.runner != nil:
+				m.runner.TogglePause()
+			case len(m.state.ScopedSpecs) > 1:
+				m.gotoScopedSpec(m.state.ScopedIndex - 1)
+			}
+		case "n":
+			if len(m.state.ScopedSpecs) > 1 {
+				m.gotoScopedSpec(m.state.ScopedIndex + 1)
+			}
+		case "left":
+			if len(m.state.ScopedSpecs) > 1 {
+				m.gotoScopedSpec(m.state.ScopedIndex - 1)
+			}
+		case "right":
+			if len(m.state.ScopedSpecs) > 1 {
+				m.gotoScopedSpec(m.state.ScopedIndex + 1)
+			}
+		case "c":
+			if m.runner != nil {
+				m.runner.Cancel()
+			}
+		case "j":
+			m.activityViewport.LineDown(1)
+		case "k":
+			m.activityViewport.LineUp(1)
+		case "h":
+			m.selectHealthRun(-1, len(runRecordsFromEntries(m.entries)))
+		case "l":
+			m.selectHealthRun(1, len(runRecordsFromEntries(m.entries)))
+		case "e":
+			if m.state.CurrentSpec != nil {
+				m.editor = newSpecEditor(m.state.CurrentSpec)
+				return m, m.editor.form.Init()
+			}
+		case "v":
+			if m.state.CurrentSpec != nil {
+				if err := spec.Validate(m.state.CurrentSpec); err != nil {
+					m.state.Notifications = append(m.state.Notifications, Notification{
+						Type:    "error",
+						Message: fmt.Sprintf("Validation failed: %v", err),
+						Time:    "now",
+					})
+					_ = m.recorder.Record("spec_validation_failed", err.Error())
+				} else {
+					m.state.Notifications = append(m.state.Notifications, Notification{
+						Type:    "success",
+						Message: "Specification is valid",
+						Time:    "now",
+					})
+					_ = m.recorder.Record("spec_validated", "")
+				}
+			}
+		}
+
+	case pullStartedMsg:
+		if m.pull == nil {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.pull.err = msg.err
+			return m, nil
+		}
+		m.pull.progress = msg.progress
+		return m, listenForPullProgress(msg.progress)
+
+	case pullProgressMsg:
+		if m.pull == nil {
+			return m, nil
+		}
+		m.pull.status = msg.Status
+		m.pull.completed = msg.Completed
+		m.pull.total = msg.Total
+		return m, listenForPullProgress(m.pull.progress)
+
+	case pullDoneMsg:
+		if m.pull == nil {
+			return m, nil
+		}
+		m.pull.done = true
+		if m.pull.err == nil {
+			_ = m.recorder.Record("model_pulled", m.pull.model)
+		}
+		return m, pollOllama(m.client, m.endpoint)
+
+	case genTickMsg:
+		if m.runner == nil {
+			return m, nil
+		}
+		return m, genTick()
+
+	case genDoneMsg:
+		if m.runner != nil {
+			recordRunOutcome(m.recorder, m.runner.Job(), msg.err)
+			if closeErr := m.runner.Close(); closeErr != nil && msg.err == nil {
+				msg.err = closeErr
+			}
+		}
+		m.runner = nil
+		m.state.ActiveGeneration = nil
+		if msg.err != nil {
+			m.state.Notifications = append(m.state.Notifications, Notification{
+				Type:    "error",
+				Message: fmt.Sprintf("Generation failed: %v", msg.err),
+				Time:    "now",
+			})
+			_ = m.recorder.Record("generation_failed", msg.err.Error())
+		} else {
+			_ = m.recorder.Record("generation_finished", "")
+		}
+		return m, nil
+
+	case activityWatchMsg:
+		if msg.err != nil {
+			return m, nil
+		}
+		m.entries = msg.entries
+		m.watcher = msg.watcher
+		m.refreshActivityViewport()
+		m.activityViewport.GotoBottom()
+		return m, listenForActivity(msg.watcher)
+
+	case activityEntryMsg:
+		m.entries = append(m.entries, activity.Entry(msg))
+		m.refreshActivityViewport()
+		return m, listenForActivity(m.watcher)
+
+	default:
+		// Catches everything the cases above don't name - notably the
+		// cursor-blink ticks huh's Input fields schedule via their own
+		// Focus()/Update commands - and forwards them on while the editor
+		// is open. tea.KeyMsg is routed to it above instead, since it also
+		// needs the fall-through to the rest of this switch when closed.
+		if m.editor != nil {
+			return m, m.routeToEditor(msg)
+		}
 	}
 	return m, nil
 }
 
+// gotoScopedSpec loads state.ScopedSpecs[index mod len(...)] into
+// CurrentSpec, wrapping around in either direction so n/p and the arrow
+// keys can cycle the list endlessly.
+func (m *MainView) gotoScopedSpec(index int) {
+	total := len(m.state.ScopedSpecs)
+	index = ((index % total) + total) % total
+	loadScopedSpec(m.state, m.recorder, index)
+}
+
+// routeToEditor forwards msg to the open spec editor, applying its result
+// to CurrentSpec and closing the editor once the form completes with a
+// validated specification or the user cancels it; an in-progress keystroke
+// just returns the form's own command.
+func (m *MainView) routeToEditor(msg tea.Msg) tea.Cmd {
+	cmd := m.editor.Update(msg)
+
+	switch {
+	case m.editor.aborted():
+		m.editor = nil
+	case m.editor.done():
+		*m.state.CurrentSpec = m.editor.working
+		_ = m.recorder.Record("spec_edited", m.state.CurrentSpec.Dataset.Domain)
+		m.editor = nil
+	}
+
+	return cmd
+}
+
+// refreshActivityViewport re-syncs activityViewport's content with
+// entries, keeping its current scroll position unless that position no
+// longer exists (SetContent itself handles snapping back to the bottom in
+// that case).
+func (m *MainView) refreshActivityViewport() {
+	m.activityViewport.SetContent(strings.Join(m.activitySectionData().Activities, "\n"))
+}
+
+// AttachRunner wires m to runner, whose per-worker progress the System
+// Status section renders until it finishes. Mirrors
+// GenerationMonitorView.AttachController's role for the detailed F4 view.
+func (m *MainView) AttachRunner(runner *GenerationRunner) tea.Cmd {
+	m.runner = runner
+	_ = m.recorder.Record("generation_started", "")
+	return tea.Batch(genTick(), listenForGenDone(runner))
+}
+
+// startInstall begins pulling model, replacing the dashboard with a
+// progress modal until it completes or fails.
+func (m *MainView) startInstall(model string) tea.Cmd {
+	m.pull = &pullModelState{
+		model: model,
+		bar:   progress.New(progress.WithDefaultGradient()),
+	}
+	m.pull.bar.Width = m.width - 8
+	return startPullModel(m.client, m.endpoint, model)
+}
+
+// updatePull handles key presses while the install modal is showing: esc
+// dismisses it once it's done (successfully or not), everything else is
+// swallowed so it can't leak through to the dashboard underneath.
+func (m *MainView) updatePull(msg tea.KeyMsg) tea.Cmd {
+	if msg.String() == "esc" && (m.pull.done || m.pull.err != nil) {
+		m.pull = nil
+	}
+	return nil
+}
+
+// installGuideKey maps runtime.GOOS to one of GetOllamaInstallInstructions'
+// keys, falling back to "linux" for anything else (docker being the other
+// option, which isn't detectable from GOOS).
+func installGuideKey() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "macos"
+	case "windows":
+		return "windows"
+	default:
+		return "linux"
+	}
+}
+
 // View implements tea.Model
 func (m *MainView) View() string {
 	if m.width == 0 {
 		return "Loading main view..."
 	}
 
+	if m.pull != nil {
+		return m.renderPullModal()
+	}
+
+	if m.editor != nil {
+		return m.renderEditorModal()
+	}
+
 	// Current Specification section
 	specSection := m.renderSpecSection()
 	
@@ -54,14 +405,12 @@ func (m *MainView) View() string {
 	activitySection := m.renderActivitySection()
 
 	// Layout sections vertically
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		specSection,
-		"",
-		statusSection,
-		"",
-		activitySection,
-	)
+	sections := []string{}
+	if m.height >= minBannerHeight {
+		sections = append(sections, m.renderBanner(), "")
+	}
+	sections = append(sections, specSection, "", statusSection, "", activitySection)
+	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
 
 	// Center the content
 	return lipgloss.NewStyle().
@@ -71,6 +420,137 @@ func (m *MainView) View() string {
 		Render(content)
 }
 
+// SpecSectionData is the "Current Specification" section's content,
+// independent of whether it ends up inside a bordered lipgloss panel or a
+// plain ASCII line (see renderPlain).
+type SpecSectionData struct {
+	Loaded     bool
+	Domain     string
+	FieldCount int
+	FieldNames string
+	Count      int
+
+	// Scoped, ScopedFiles, and ScopedIndex are only populated when the TUI
+	// was launched against a path (`fauxfoundry tui <path>`, or --spec):
+	// ScopedFiles is every *.yaml/*.yml basename resolveScopedSpecs found
+	// there (one entry for a single file), and ScopedIndex is which of
+	// them Domain/FieldCount/etc. above describe.
+	Scoped      bool
+	ScopedFiles []string
+	ScopedIndex int
+}
+
+// specSectionData builds SpecSectionData from the currently loaded spec, if
+// any, and the scoped file list, if the TUI was launched against a path.
+func (m *MainView) specSectionData() SpecSectionData {
+	data := SpecSectionData{}
+	if len(m.state.ScopedSpecs) > 0 {
+		data.Scoped = true
+		data.ScopedIndex = m.state.ScopedIndex
+		data.ScopedFiles = make([]string, len(m.state.ScopedSpecs))
+		for i, path := range m.state.ScopedSpecs {
+			data.ScopedFiles[i] = filepath.Base(path)
+		}
+	}
+	if m.state.CurrentSpec == nil {
+		return data
+	}
+	data.Loaded = true
+	data.Domain = m.state.CurrentSpec.Dataset.Domain
+	data.FieldCount = len(m.state.CurrentSpec.Dataset.Fields)
+	data.FieldNames = m.getFieldNames()
+	data.Count = m.state.CurrentSpec.Dataset.Count
+	return data
+}
+
+// renderPlain renders the same three sections as View, stripped of lipgloss
+// borders and ANSI colors, for piping fauxfoundry tui --plain into grep, a
+// cron log, or CI output.
+func (m *MainView) renderPlain() string {
+	var b strings.Builder
+
+	spec := m.specSectionData()
+	b.WriteString("Current Specification\n")
+	if spec.Scoped {
+		b.WriteString("  Specs:\n")
+		for i, name := range spec.ScopedFiles {
+			marker := "    "
+			if i == spec.ScopedIndex {
+				marker = "  > "
+			}
+			fmt.Fprintf(&b, "%s%s\n", marker, name)
+		}
+	}
+	if spec.Loaded {
+		fmt.Fprintf(&b, "  %s\n  Domain: %s\n  Fields: %d (%s)\n  Target: %d records\n",
+			m.specSectionName(spec), spec.Domain, spec.FieldCount, spec.FieldNames, spec.Count)
+	} else {
+		b.WriteString("  No specification loaded\n")
+	}
+
+	status := m.statusSectionData()
+	fmt.Fprintf(&b, "\nSystem Status\n  %s\n", status.Status)
+	for _, line := range strings.Split(status.Details, "\n") {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+	fmt.Fprintf(&b, "  Actions: %s\n", strings.Join(status.Buttons, ", "))
+	for i, w := range status.Workers {
+		fmt.Fprintf(&b, "  Worker %d: %d/%d\n", i+1, w.Current, w.Total)
+	}
+	if len(status.Workers) > 0 {
+		fmt.Fprintf(&b, "  Overall: %d/%d\n", status.Aggregate.Current, status.Aggregate.Total)
+	}
+
+	b.WriteString("\nRecent Activity\n")
+	if health := m.healthSectionData(); len(health.Runs) > 0 {
+		glyphs := make([]string, len(health.Runs))
+		for i, run := range health.Runs {
+			glyphs[i] = healthStripGlyph(run.Outcome)
+		}
+		fmt.Fprintf(&b, "  Runs: %s\n", strings.Join(glyphs, " "))
+		if health.Selected >= 0 && health.Selected < len(health.Runs) {
+			run := health.Runs[health.Selected]
+			detail := fmt.Sprintf("%s - %s, %d/%d records, %s", run.Spec, run.Model, run.Generated, run.Target, run.Duration)
+			if run.Error != "" {
+				detail = fmt.Sprintf("%s, %s", detail, run.Error)
+			}
+			fmt.Fprintf(&b, "  Selected: %s\n", detail)
+		}
+	}
+	for _, line := range m.activitySectionData().Activities {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+
+	return b.String()
+}
+
+// renderBanner renders the startup logo: logoRows, each colored from
+// Theme.LogoForeground (cycling if there are more rows than colors), plus
+// a subtitle line with the version, build commit, and Ollama endpoint -
+// hidden below minBannerHeight so it never crowds out the sections that
+// actually matter.
+func (m *MainView) renderBanner() string {
+	colors := m.theme.LogoForeground
+	if len(colors) == 0 {
+		colors = []lipgloss.Color{m.theme.Primary}
+	}
+
+	lines := make([]string, len(logoRows))
+	for i, row := range logoRows {
+		style := lipgloss.NewStyle().Foreground(colors[i%len(colors)])
+		if len(m.theme.LogoBackground) > 0 {
+			style = style.Background(m.theme.LogoBackground[i%len(m.theme.LogoBackground)])
+		}
+		lines[i] = style.Render(row)
+	}
+
+	subtitle := lipgloss.NewStyle().
+		Foreground(m.theme.Secondary).
+		Render(fmt.Sprintf("v%s (%s) · %s", buildinfo.Version, buildinfo.Commit, m.endpoint))
+
+	return lipgloss.JoinVertical(lipgloss.Center, lipgloss.JoinVertical(lipgloss.Left, lines...), subtitle)
+}
+
 // renderSpecSection renders the current specification section
 func (m *MainView) renderSpecSection() string {
 	sectionStyle := lipgloss.NewStyle().
@@ -105,18 +585,29 @@ func (m *MainView) renderSpecSection() string {
 		buttons,
 	)
 
+	data := m.specSectionData()
 	var content string
-	if m.state.CurrentSpec != nil {
+	if data.Loaded {
 		content = fmt.Sprintf(
-			"customer.yaml\nDomain: %s\nFields: %d (%s)\nTarget: %d records",
-			m.state.CurrentSpec.Dataset.Domain,
-			len(m.state.CurrentSpec.Dataset.Fields),
-			m.getFieldNames(),
-			m.state.CurrentSpec.Dataset.Count,
+			"%s\nDomain: %s\nFields: %d (%s)\nTarget: %d records\n[e] Edit   [v] Validate",
+			m.specSectionName(data), data.Domain, data.FieldCount, data.FieldNames, data.Count,
 		)
 	} else {
 		content = "No specification loaded\nPress F2 to browse specifications or Ctrl+N to create new"
 	}
+	if data.Scoped {
+		listStyle := lipgloss.NewStyle().Foreground(m.theme.Secondary)
+		selectedStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+		lines := make([]string, len(data.ScopedFiles))
+		for i, name := range data.ScopedFiles {
+			if i == data.ScopedIndex {
+				lines[i] = selectedStyle.Render("> " + name)
+			} else {
+				lines[i] = listStyle.Render("  " + name)
+			}
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left, strings.Join(lines, "\n"), "[n/p] Switch spec", "", content)
+	}
 
 	return sectionStyle.Render(
 		lipgloss.JoinVertical(
@@ -128,6 +619,89 @@ func (m *MainView) renderSpecSection() string {
 	)
 }
 
+// StatusSectionData is the "System Status" section's content: a plain-text
+// status line, its supporting detail, and the buttons available from it.
+// Neither field carries lipgloss styling so it can back both the TUI panel
+// and the plain ASCII dashboard.
+type StatusSectionData struct {
+	Status  string
+	Details string
+	Buttons []string
+
+	// Workers and Aggregate are only populated while a GenerationRunner is
+	// attached (see AttachRunner): one GenericProgress per concurrent
+	// worker slot, plus the overall job total.
+	Workers   []GenericProgress
+	Aggregate GenericProgress
+}
+
+// statusSectionData derives StatusSectionData from the shared Ollama poll
+// result and any active generation, in the same precedence renderStatusSection
+// used to render directly: unchecked, then unreachable, then no models
+// installed, then an active generation, then ready-to-generate.
+func (m *MainView) statusSectionData() StatusSectionData {
+	ollama := m.state.Ollama
+	ollamaRunning := ollama != nil && ollama.Running
+	var ollamaModels []string
+	if ollama != nil {
+		ollamaModels = ollama.Models
+	}
+
+	switch {
+	case ollama == nil:
+		return StatusSectionData{
+			Status:  "⏳ Checking Ollama...",
+			Details: fmt.Sprintf("Waiting for the first health check against %s", m.endpoint),
+			Buttons: []string{"Refresh"},
+		}
+	case !ollamaRunning:
+		return StatusSectionData{
+			Status:  "❌ Ollama not running",
+			Details: fmt.Sprintf("Ollama is required for data generation (%s)\n[g] Setup Guide   [r] Refresh", ollama.Err),
+			Buttons: []string{"Setup Guide", "Refresh"},
+		}
+	case len(ollamaModels) == 0:
+		return StatusSectionData{
+			Status:  "⚠️  No models installed",
+			Details: "At least one model is required\nRecommended: llama3.1:8b\n[i] Install Model   [r] Refresh",
+			Buttons: []string{"Install Model", "Refresh"},
+		}
+	case m.state.ActiveGeneration != nil:
+		data := StatusSectionData{
+			Status: fmt.Sprintf("🔄 %s", m.state.ActiveGeneration.Status),
+			Details: fmt.Sprintf(
+				"Progress: %d/%d records\nModel: %s\nElapsed: %s",
+				m.state.ActiveGeneration.Progress.Generated,
+				m.state.ActiveGeneration.Progress.Target,
+				m.state.ActiveGeneration.Spec.Model.Name,
+				m.state.ActiveGeneration.Progress.ElapsedTime,
+			),
+			Buttons: []string{"Pause", "Cancel"},
+		}
+		if m.runner != nil {
+			perWorker, aggregate := m.runner.Snapshot()
+			data.Workers = make([]GenericProgress, m.runner.Workers())
+			for id, p := range perWorker {
+				if id >= 0 && id < len(data.Workers) {
+					data.Workers[id] = p
+				}
+			}
+			data.Aggregate = aggregate
+		}
+		return data
+	default:
+		return StatusSectionData{
+			Status: "✅ Ready to generate",
+			Details: fmt.Sprintf(
+				"Ollama: Connected\nModels: %d available (%s)\nEstimated time: ~2 minutes",
+				len(ollamaModels),
+				ollamaModels[0],
+			),
+			Buttons: []string{"Generate", "Settings", "Preview"},
+		}
+	}
+}
+
 // renderStatusSection renders the generation status section
 func (m *MainView) renderStatusSection() string {
 	sectionStyle := lipgloss.NewStyle().
@@ -155,71 +729,39 @@ func (m *MainView) renderStatusSection() string {
 
 	title := titleStyle.Render("System Status")
 
-	var status, details string
-	var buttons string
+	data := m.statusSectionData()
 
-	// Check Ollama status (this would be populated by actual health check)
-	ollamaRunning := true // TODO: Get from actual health check
-	ollamaModels := []string{"llama3.1:8b", "mistral:7b"} // TODO: Get from actual health check
+	var status string
+	switch {
+	case data.Status == "✅ Ready to generate":
+		status = statusStyle.Render(data.Status)
+	case strings.HasPrefix(data.Status, "❌"), strings.HasPrefix(data.Status, "⚠️"):
+		status = errorStyle.Render(data.Status)
+	default:
+		status = lipgloss.NewStyle().Foreground(m.theme.Secondary).Render(data.Status)
+	}
 
-	if !ollamaRunning {
-		status = errorStyle.Render("❌ Ollama not running")
-		details = "Ollama is required for data generation\nRun 'fauxfoundry doctor' for setup instructions"
-		buttons = lipgloss.JoinHorizontal(
-			lipgloss.Left,
-			buttonStyle.Render("Setup Guide"),
-			" ",
-			buttonStyle.Render("Refresh"),
-		)
-	} else if len(ollamaModels) == 0 {
-		status = errorStyle.Render("⚠️  No models installed")
-		details = "At least one model is required\nRecommended: llama3.1:8b"
-		buttons = lipgloss.JoinHorizontal(
-			lipgloss.Left,
-			buttonStyle.Render("Install Model"),
-			" ",
-			buttonStyle.Render("Refresh"),
-		)
-	} else if m.state.ActiveGeneration != nil {
-		status = fmt.Sprintf("🔄 %s", m.state.ActiveGeneration.Status)
-		details = fmt.Sprintf(
-			"Progress: %d/%d records\nModel: %s\nElapsed: %s",
-			m.state.ActiveGeneration.Progress.Generated,
-			m.state.ActiveGeneration.Progress.Target,
-			m.state.ActiveGeneration.Spec.Model.Name,
-			m.state.ActiveGeneration.Progress.ElapsedTime,
-		)
-		buttons = lipgloss.JoinHorizontal(
-			lipgloss.Left,
-			buttonStyle.Render("Pause"),
-			" ",
-			buttonStyle.Render("Cancel"),
-		)
-	} else {
-		status = statusStyle.Render("✅ Ready to generate")
-		details = fmt.Sprintf(
-			"Ollama: Connected\nModels: %d available (%s)\nEstimated time: ~2 minutes",
-			len(ollamaModels),
-			ollamaModels[0],
-		)
-		buttons = lipgloss.JoinHorizontal(
-			lipgloss.Left,
-			buttonStyle.Render("Generate"),
-			" ",
-			buttonStyle.Render("Settings"),
-			" ",
-			buttonStyle.Render("Preview"),
-		)
+	buttonViews := make([]string, 0, len(data.Buttons)*2-1)
+	for i, label := range data.Buttons {
+		if i > 0 {
+			buttonViews = append(buttonViews, " ")
+		}
+		buttonViews = append(buttonViews, buttonStyle.Render(label))
 	}
+	buttons := lipgloss.JoinHorizontal(lipgloss.Left, buttonViews...)
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Left,
 		status,
-		details,
+		data.Details,
 		"",
 		buttons,
 	)
 
+	if len(data.Workers) > 0 {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", m.renderWorkerBars(data))
+	}
+
 	return sectionStyle.Render(
 		lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -230,7 +772,135 @@ func (m *MainView) renderStatusSection() string {
 	)
 }
 
-// renderActivitySection renders the recent activity section
+// renderWorkerBars renders a stacked progress.Model per worker in
+// data.Workers, plus an aggregate bar for data.Aggregate.
+func (m *MainView) renderWorkerBars(data StatusSectionData) string {
+	barWidth := m.width - 18
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(m.theme.Secondary)
+
+	var rows []string
+	for i, w := range data.Workers {
+		bar := progress.New(progress.WithDefaultGradient())
+		bar.Width = barWidth
+		rows = append(rows, fmt.Sprintf("%s %s",
+			labelStyle.Render(fmt.Sprintf("Worker %d:", i+1)),
+			bar.ViewAs(fractionOf(w)),
+		))
+	}
+
+	aggBar := progress.New(progress.WithDefaultGradient())
+	aggBar.Width = barWidth
+	rows = append(rows, "", fmt.Sprintf("%s  %s",
+		lipgloss.NewStyle().Foreground(m.theme.Primary).Bold(true).Render("Overall: "),
+		aggBar.ViewAs(fractionOf(data.Aggregate)),
+	))
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// fractionOf returns p.Current/p.Total as a 0-1 fraction, or 0 if Total is
+// unset (a worker that hasn't started its first batch yet).
+func fractionOf(p GenericProgress) float64 {
+	if p.Total <= 0 {
+		return 0
+	}
+	return float64(p.Current) / float64(p.Total)
+}
+
+// renderPullModal renders the "Install Model" progress modal, replacing the
+// dashboard while m.pull is set.
+func (m *MainView) renderPullModal() string {
+	style := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Primary)
+
+	title := lipgloss.NewStyle().
+		Foreground(m.theme.Primary).
+		Bold(true).
+		Render(fmt.Sprintf("📥 Installing %s", m.pull.model))
+
+	var body string
+	switch {
+	case m.pull.err != nil:
+		body = lipgloss.NewStyle().Foreground(m.theme.Error).Render(fmt.Sprintf("Install failed: %v", m.pull.err)) +
+			"\n\n" + lipgloss.NewStyle().Foreground(m.theme.Secondary).Render("[esc] close")
+	case m.pull.done:
+		body = lipgloss.NewStyle().Foreground(m.theme.Success).Render("✅ Install complete.") +
+			"\n\n" + lipgloss.NewStyle().Foreground(m.theme.Secondary).Render("[esc] close")
+	default:
+		var pct float64
+		if m.pull.total > 0 {
+			pct = float64(m.pull.completed) / float64(m.pull.total)
+		}
+		status := m.pull.status
+		if status == "" {
+			status = "starting..."
+		}
+		body = m.pull.bar.ViewAs(pct) + "\n" + status
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, title, "", body))
+}
+
+// renderEditorModal renders the spec editor's embedded huh.Form, replacing
+// the dashboard while m.editor is set.
+func (m *MainView) renderEditorModal() string {
+	style := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(1, 2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Primary)
+
+	title := lipgloss.NewStyle().
+		Foreground(m.theme.Primary).
+		Bold(true).
+		Render("✏️  Edit Specification")
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, title, "", m.editor.form.View()))
+}
+
+// ActivitySectionData is the "Recent Activity" section's content: every
+// recorded activity.Entry rendered as one line, oldest first -
+// activityViewport (not this struct) is what limits how many are visible
+// at once.
+type ActivitySectionData struct {
+	Activities []string
+}
+
+// activitySectionData renders every activity.Entry loaded/tailed so far as
+// "<relative time> - <action>: <detail>" lines, or a canned sample list
+// when nothing's been recorded yet.
+func (m *MainView) activitySectionData() ActivitySectionData {
+	if len(m.entries) == 0 {
+		return ActivitySectionData{Activities: []string{
+			"12:30 PM - customer.yaml validated successfully",
+			"12:28 PM - Generated 500 product records",
+			"12:25 PM - Created new specification: products.yaml",
+		}}
+	}
+
+	activities := make([]string, 0, len(m.entries))
+	for _, e := range m.entries {
+		line := e.Action
+		if e.Detail != "" {
+			line = fmt.Sprintf("%s: %s", e.Action, e.Detail)
+		}
+		activities = append(activities, fmt.Sprintf("%s - %s", relativeTime(e.Time), line))
+	}
+	return ActivitySectionData{Activities: activities}
+}
+
+// renderActivitySection renders the recent activity section as a
+// scrollable viewport ([j]/[k]) over the full persisted log, rather than
+// only ever showing the last three lines.
 func (m *MainView) renderActivitySection() string {
 	sectionStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -242,39 +912,19 @@ func (m *MainView) renderActivitySection() string {
 		Foreground(m.theme.Secondary).
 		Bold(true)
 
-	title := titleStyle.Render("Recent Activity")
-
-	// Default activity if no history
-	activities := []string{
-		"12:30 PM - customer.yaml validated successfully",
-		"12:28 PM - Generated 500 product records",
-		"12:25 PM - Created new specification: products.yaml",
-	}
+	hintStyle := lipgloss.NewStyle().
+		Foreground(m.theme.Secondary)
 
-	// Use actual history if available
-	if len(m.state.History) > 0 {
-		activities = []string{}
-		for i, entry := range m.state.History {
-			if i >= 3 { // Show only last 3 entries
-				break
-			}
-			activities = append(activities, fmt.Sprintf("%s - %s", entry.Timestamp, entry.Action))
-		}
-	}
+	title := titleStyle.Render("Recent Activity")
+	hint := hintStyle.Render("[j/k] scroll")
 
-	content := ""
-	for _, activity := range activities {
-		content += activity + "\n"
+	rows := []string{title, ""}
+	if strip := m.renderHealthStrip(m.healthSectionData()); strip != "" {
+		rows = append(rows, strip, "")
 	}
+	rows = append(rows, m.activityViewport.View(), hint)
 
-	return sectionStyle.Render(
-		lipgloss.JoinVertical(
-			lipgloss.Left,
-			title,
-			"",
-			content,
-		),
-	)
+	return sectionStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
 }
 
 // getFieldNames returns a comma-separated list of field names
@@ -294,3 +944,13 @@ func (m *MainView) getFieldNames() string {
 	}
 	return fmt.Sprintf("%s, %s, %s, ...", names[0], names[1], names[2])
 }
+
+// specSectionName is the filename shown above the Domain/Fields/Target
+// summary: the currently selected scoped spec's basename when one is set,
+// or the "customer.yaml" placeholder otherwise.
+func (m *MainView) specSectionName(data SpecSectionData) string {
+	if data.Scoped && data.ScopedIndex < len(data.ScopedFiles) {
+		return data.ScopedFiles[data.ScopedIndex]
+	}
+	return "customer.yaml"
+}
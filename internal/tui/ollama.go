@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/copyleftdev/faux-foundry/internal/llm"
+)
+
+// ollamaPollInterval is how often App re-checks Ollama's reachability and
+// installed model list while the TUI is running.
+const ollamaPollInterval = 5 * time.Second
+
+// OllamaStatus is the last-known result of App's periodic Ollama poll,
+// stored on AppState so any view can read it (e.g. the spec editor warning
+// about an unreachable endpoint) without running its own check.
+type OllamaStatus struct {
+	Running       bool
+	RunningModels []llm.OllamaRunningModel
+	Models        []string
+	LastChecked   time.Time
+	Err           error
+
+	// statusErr/modelsErr are each check's latest error, nil after a success.
+	statusErr, modelsErr error
+}
+
+// ollamaTickMsg fires every ollamaPollInterval to trigger the next poll.
+type ollamaTickMsg struct{}
+
+// ollamaStatusMsg carries a completed /api/ps check into Update: whether
+// Ollama answered at all, and what it currently has loaded.
+type ollamaStatusMsg struct {
+	runningModels []llm.OllamaRunningModel
+	err           error
+}
+
+// ollamaModelsMsg carries a completed /api/tags check (installed models)
+// into Update.
+type ollamaModelsMsg struct {
+	models []string
+	err    error
+}
+
+// ollamaTick schedules the next poll.
+func ollamaTick() tea.Cmd {
+	return tea.Tick(ollamaPollInterval, func(time.Time) tea.Msg { return ollamaTickMsg{} })
+}
+
+// pollOllamaStatus pings endpoint's /api/ps.
+func pollOllamaStatus(client *llm.OllamaClient, endpoint string) tea.Cmd {
+	return func() tea.Msg {
+		models, err := client.RunningModels(context.Background(), endpoint)
+		return ollamaStatusMsg{runningModels: models, err: err}
+	}
+}
+
+// pollOllamaModels pings endpoint's /api/tags.
+func pollOllamaModels(client *llm.OllamaClient, endpoint string) tea.Cmd {
+	return func() tea.Msg {
+		models, err := client.ListModels(context.Background(), endpoint)
+		return ollamaModelsMsg{models: models, err: err}
+	}
+}
+
+// pollOllama issues both checks together, used for App's startup poll and
+// MainView's "Refresh" button.
+func pollOllama(client *llm.OllamaClient, endpoint string) tea.Cmd {
+	return tea.Batch(pollOllamaStatus(client, endpoint), pollOllamaModels(client, endpoint))
+}
+
+// applyOllamaStatus folds a /api/ps result into state.Ollama. Either poll
+// succeeding means the server is up, so Running only goes false once both
+// the status and models checks have failed.
+func applyOllamaStatus(state *AppState, msg ollamaStatusMsg) {
+	status := state.ollamaStatus()
+	status.LastChecked = time.Now()
+	status.statusErr = msg.err
+	if msg.err != nil {
+		if status.modelsErr != nil {
+			status.Running = false
+		}
+		if !status.Running {
+			status.Err = msg.err
+		}
+		return
+	}
+	status.Running = true
+	status.RunningModels = msg.runningModels
+	status.Err = nil
+}
+
+// applyOllamaModels folds a /api/tags result into state.Ollama.
+func applyOllamaModels(state *AppState, msg ollamaModelsMsg) {
+	status := state.ollamaStatus()
+	status.LastChecked = time.Now()
+	status.modelsErr = msg.err
+	if msg.err != nil {
+		if status.statusErr != nil {
+			status.Running = false
+		}
+		if !status.Running {
+			status.Err = msg.err
+		}
+		return
+	}
+	status.Running = true
+	status.Models = msg.models
+	status.Err = nil
+}
+
+// ollamaStatus returns s.Ollama, allocating it on first use.
+func (s *AppState) ollamaStatus() *OllamaStatus {
+	if s.Ollama == nil {
+		s.Ollama = &OllamaStatus{}
+	}
+	return s.Ollama
+}
+
+// pullStartedMsg carries the outcome of requesting /api/pull into Update.
+type pullStartedMsg struct {
+	progress <-chan llm.PullProgress
+	err      error
+}
+
+// pullProgressMsg carries a single NDJSON progress line from an in-flight
+// pull into Update.
+type pullProgressMsg llm.PullProgress
+
+// pullDoneMsg signals that a pull's progress channel has closed.
+type pullDoneMsg struct{}
+
+// startPullModel requests /api/pull for model and begins streaming its
+// progress.
+func startPullModel(client *llm.OllamaClient, endpoint, model string) tea.Cmd {
+	return func() tea.Msg {
+		progress, err := client.PullModelStream(context.Background(), endpoint, model)
+		return pullStartedMsg{progress: progress, err: err}
+	}
+}
+
+// listenForPullProgress returns a tea.Cmd that reads the next PullProgress
+// off progress, re-issuing itself from Update until the channel closes.
+func listenForPullProgress(progress <-chan llm.PullProgress) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-progress
+		if !ok {
+			return pullDoneMsg{}
+		}
+		return pullProgressMsg(p)
+	}
+}
@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+
+	"github.com/copyleftdev/faux-foundry/internal/activity"
+)
+
+// shouldRenderPlain reports whether the TUI should print a one-shot ASCII
+// dashboard instead of launching the interactive Bubble Tea program:
+// forcePlain (--plain) always wins; otherwise NO_COLOR or stdout not being a
+// terminal (piped into grep, a cron log, or CI output) trigger it too.
+func shouldRenderPlain(forcePlain bool) bool {
+	if forcePlain {
+		return true
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return true
+	}
+	return !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd())
+}
+
+// RunPlain builds the same App Run would and prints a stripped rendition of
+// MainView's dashboard - no lipgloss borders or ANSI colors - instead of
+// entering the Bubble Tea event loop. The Ollama checks App otherwise runs
+// on a tea.Tick are done once, synchronously, since there's no event loop
+// to drive them here.
+func RunPlain(specFile string) error {
+	app := NewApp(specFile)
+
+	runningModels, runErr := app.ollama.RunningModels(context.Background(), app.ollamaEndpoint)
+	applyOllamaStatus(app.state, ollamaStatusMsg{runningModels: runningModels, err: runErr})
+
+	models, modelsErr := app.ollama.ListModels(context.Background(), app.ollamaEndpoint)
+	applyOllamaModels(app.state, ollamaModelsMsg{models: models, err: modelsErr})
+
+	main, _ := app.views[ViewMain].(*MainView)
+	if entries, err := activity.Load(main.recorder.Path); err == nil {
+		main.entries = entries
+	}
+	fmt.Print(main.renderPlain())
+	return nil
+}
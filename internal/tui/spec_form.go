@@ -0,0 +1,288 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/copyleftdev/faux-foundry/internal/spec"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// specEditorState drives the huh.Form the "Edit" button opens over the
+// Current Specification section (see MainView.renderEditorModal), following
+// the dynamic-bubbletea pattern from charmbracelet/huh's examples: the form
+// is rebuilt - not mutated - whenever the field count changes or submit-time
+// validation fails, while each field's type-specific constraint group hides
+// or shows itself live via WithHideFunc bound to that field's own Type
+// selection.
+type specEditorState struct {
+	form *huh.Form
+
+	// working is edited in place by the form's bound fields. It only
+	// replaces AppState.CurrentSpec on a clean, validated completion.
+	working types.Specification
+
+	// countS backs the Count input as text, since huh.Input only binds to
+	// *string; it's parsed back into working.Dataset.Count on submit.
+	countS string
+
+	// ranges, enumCSV, and remove are parallel to working.Dataset.Fields,
+	// backing that field's type-specific constraint group.
+	ranges  []fieldRange
+	enumCSV []string
+	remove  []bool
+
+	addMore bool
+
+	// err is the last spec.Validate failure, shown as a dismissible note
+	// at the top of the rebuilt form until the next successful submit.
+	err error
+}
+
+// fieldRange backs one field's Range [min, max] as text.
+type fieldRange struct {
+	min, max string
+}
+
+// newSpecEditor copies current into a fresh specEditorState and builds its
+// form. current is never mutated directly; only a successful, validated
+// submit is copied back out (see MainView.routeToEditor).
+func newSpecEditor(current *types.Specification) *specEditorState {
+	e := &specEditorState{}
+	if current != nil {
+		e.working = *current
+		e.working.Dataset.Fields = append([]types.Field(nil), current.Dataset.Fields...)
+	}
+	e.countS = strconv.Itoa(e.working.Dataset.Count)
+	e.syncSlices()
+	e.form = e.buildForm()
+	return e
+}
+
+// syncSlices grows ranges/enumCSV/remove to match working.Dataset.Fields,
+// seeding each from that field's current Range/Values so a field that
+// already has constraints doesn't lose them when the form is rebuilt.
+func (e *specEditorState) syncSlices() {
+	n := len(e.working.Dataset.Fields)
+	e.ranges = make([]fieldRange, n)
+	e.enumCSV = make([]string, n)
+	e.remove = make([]bool, n)
+	for i, f := range e.working.Dataset.Fields {
+		if len(f.Range) == 2 {
+			e.ranges[i] = fieldRange{min: strconv.Itoa(f.Range[0]), max: strconv.Itoa(f.Range[1])}
+		}
+		e.enumCSV[i] = strings.Join(f.Values, ", ")
+	}
+}
+
+// isPatternType reports whether t's fields take a regex Pattern.
+func isPatternType(t string) bool {
+	return t == "string" || t == "text"
+}
+
+// isRangeType reports whether t's fields take a numeric Range.
+func isRangeType(t string) bool {
+	return t == "integer" || t == "float"
+}
+
+// splitCSV turns a comma-separated string into its trimmed, non-empty
+// parts, the shape huh.NewOptions and Join(..., ", ") expect.
+func splitCSV(s string) []string {
+	var values []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// buildForm lays out one group for Domain/Count, then per field a basic
+// group (name, type, required, remove) plus three constraint groups hidden
+// via WithHideFunc - Pattern for string/text, Range for integer/float, and
+// allowed values for enum - and a closing group offering to add another
+// field. When e.err is set, a leading note group surfaces it before any of
+// that is editable again.
+func (e *specEditorState) buildForm() *huh.Form {
+	var groups []*huh.Group
+
+	if e.err != nil {
+		groups = append(groups, huh.NewGroup(
+			huh.NewNote().
+				Title("Validation failed").
+				Description(e.err.Error()),
+		))
+	}
+
+	groups = append(groups, huh.NewGroup(
+		huh.NewInput().
+			Title("Domain").
+			Value(&e.working.Dataset.Domain).
+			Validate(func(s string) error {
+				if strings.TrimSpace(s) == "" {
+					return fmt.Errorf("domain is required")
+				}
+				return nil
+			}),
+		huh.NewInput().
+			Title("Target record count").
+			Value(&e.countS).
+			Validate(func(s string) error {
+				n, err := strconv.Atoi(strings.TrimSpace(s))
+				if err != nil || n <= 0 {
+					return fmt.Errorf("count must be a positive integer")
+				}
+				return nil
+			}),
+	).Title("Dataset"))
+
+	for i := range e.working.Dataset.Fields {
+		field := &e.working.Dataset.Fields[i]
+
+		groups = append(groups, huh.NewGroup(
+			huh.NewInput().
+				Title(fmt.Sprintf("Field %d name", i+1)).
+				Value(&field.Name),
+			huh.NewSelect[string]().
+				Title("Type").
+				Options(huh.NewOptions(spec.ValidFieldTypes()...)...).
+				Value(&field.Type),
+			huh.NewConfirm().
+				Title("Required?").
+				Value(&field.Required),
+			huh.NewConfirm().
+				Title("Remove this field?").
+				Value(&e.remove[i]),
+		).Title(fmt.Sprintf("Field %d", i+1)))
+
+		groups = append(groups, huh.NewGroup(
+			huh.NewInput().
+				Title("Pattern (regex, optional)").
+				Value(&field.Pattern),
+		).WithHideFunc(func() bool { return !isPatternType(field.Type) }))
+
+		groups = append(groups, huh.NewGroup(
+			huh.NewInput().Title("Range min").Value(&e.ranges[i].min),
+			huh.NewInput().Title("Range max").Value(&e.ranges[i].max),
+		).WithHideFunc(func() bool { return !isRangeType(field.Type) }))
+
+		csv := &e.enumCSV[i]
+		groups = append(groups, huh.NewGroup(
+			huh.NewInput().
+				Title("Allowed values (comma-separated)").
+				Value(csv),
+			huh.NewMultiSelect[string]().
+				Title("Keep which values?").
+				OptionsFunc(func() []huh.Option[string] {
+					values := splitCSV(*csv)
+					opts := make([]huh.Option[string], len(values))
+					for j, v := range values {
+						opts[j] = huh.NewOption(v, v).Selected(true)
+					}
+					return opts
+				}, csv).
+				Value(&field.Values),
+		).WithHideFunc(func() bool { return field.Type != "enum" }))
+	}
+
+	groups = append(groups, huh.NewGroup(
+		huh.NewConfirm().
+			Title("Add another field?").
+			Value(&e.addMore),
+	).Title("Fields"))
+
+	return huh.NewForm(groups...)
+}
+
+// applyConstraints folds each field's type-specific scratch state (ranges,
+// enumCSV) back into its Range/Values, and clears whichever of the two
+// doesn't apply to its current Type - covering a field whose Type changed
+// after a Range or Values was already set.
+func (e *specEditorState) applyConstraints() {
+	for i := range e.working.Dataset.Fields {
+		field := &e.working.Dataset.Fields[i]
+
+		field.Range = nil
+		if isRangeType(field.Type) {
+			min, minErr := strconv.Atoi(strings.TrimSpace(e.ranges[i].min))
+			max, maxErr := strconv.Atoi(strings.TrimSpace(e.ranges[i].max))
+			if minErr == nil && maxErr == nil {
+				field.Range = []int{min, max}
+			}
+		}
+
+		if field.Type != "enum" {
+			field.Values = nil
+		}
+		if !isPatternType(field.Type) {
+			field.Pattern = ""
+		}
+	}
+}
+
+// applyRemovals drops every field flagged via its "Remove this field?"
+// confirm and re-seeds ranges/enumCSV/remove for what's left.
+func (e *specEditorState) applyRemovals() {
+	kept := e.working.Dataset.Fields[:0:0]
+	for i, field := range e.working.Dataset.Fields {
+		if e.remove[i] {
+			continue
+		}
+		kept = append(kept, field)
+	}
+	e.working.Dataset.Fields = kept
+	e.syncSlices()
+}
+
+// Update advances the embedded form. When it reaches StateCompleted, the
+// in-progress edits are normalized back into typed Fields; an "add another
+// field" request or a spec.Validate failure rebuilds the form instead of
+// closing the editor, so only a clean, validated completion leaves
+// e.done() true.
+func (e *specEditorState) Update(msg tea.Msg) tea.Cmd {
+	form, cmd := e.form.Update(msg)
+	e.form = form.(*huh.Form)
+
+	if e.form.State != huh.StateCompleted {
+		return cmd
+	}
+
+	if n, convErr := strconv.Atoi(strings.TrimSpace(e.countS)); convErr == nil {
+		e.working.Dataset.Count = n
+	}
+	e.applyConstraints()
+	e.applyRemovals()
+
+	if e.addMore {
+		e.addMore = false
+		e.working.Dataset.Fields = append(e.working.Dataset.Fields, types.Field{Type: "string"})
+		e.syncSlices()
+		e.form = e.buildForm()
+		return e.form.Init()
+	}
+
+	if err := spec.Validate(&e.working); err != nil {
+		e.err = err
+		e.form = e.buildForm()
+		return e.form.Init()
+	}
+
+	e.err = nil
+	return nil
+}
+
+// done reports whether the editor finished with a validated specification
+// ready to replace AppState.CurrentSpec.
+func (e *specEditorState) done() bool {
+	return e.form.State == huh.StateCompleted && e.err == nil
+}
+
+// aborted reports whether the user cancelled the editor (esc/ctrl+c)
+// instead of completing it.
+func (e *specEditorState) aborted() bool {
+	return e.form.State == huh.StateAborted
+}
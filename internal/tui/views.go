@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"fmt"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -91,58 +93,62 @@ func (v *SpecBrowserView) View() string {
 		"File management with preview\n" +
 		"• Browse and search specifications\n" +
 		"• Preview spec details\n" +
-		"• Quick actions (edit, duplicate, delete)\n\n" +
-		lipgloss.NewStyle().
+		"• Quick actions (edit, duplicate, delete)\n\n"
+
+	if len(v.state.History) == 0 {
+		content += lipgloss.NewStyle().
 			Foreground(v.theme.Secondary).
-			Render("Coming soon in the next implementation phase!")
+			Render("No generation runs recorded yet — run `fauxfoundry generate` to start one.")
+	} else {
+		content += lipgloss.NewStyle().
+			Foreground(v.theme.Secondary).
+			Render("Run history (branch with `fauxfoundry history branch <job_id>`):") + "\n" +
+			renderHistoryTree(v.state.History)
+	}
 
 	return style.Render(content)
 }
 
-// GenerationMonitorView represents the generation monitor
-type GenerationMonitorView struct {
-	state  *AppState
-	theme  *Theme
-	width  int
-	height int
-}
-
-func NewGenerationMonitorView(state *AppState, theme *Theme) *GenerationMonitorView {
-	return &GenerationMonitorView{state: state, theme: theme}
-}
-
-func (v *GenerationMonitorView) Init() tea.Cmd { return nil }
+// renderHistoryTree renders entries as an indented tree: each run started
+// from scratch (ParentID == "") at the left margin, followed by its
+// branches (and their branches) indented one level per generation, in the
+// order internal/history.Store.List already sorted them (oldest first).
+func renderHistoryTree(entries []HistoryEntry) string {
+	children := make(map[string][]HistoryEntry)
+	var roots []HistoryEntry
+	for _, e := range entries {
+		if e.ParentID == "" {
+			roots = append(roots, e)
+		} else {
+			children[e.ParentID] = append(children[e.ParentID], e)
+		}
+	}
 
-func (v *GenerationMonitorView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		v.width = msg.Width
-		v.height = msg.Height - 4
+	var out string
+	var walk func(e HistoryEntry, depth int)
+	walk = func(e HistoryEntry, depth int) {
+		out += fmt.Sprintf("%s%s %s — %s\n", indent(depth), e.Timestamp, e.Details, e.JobID)
+		for _, child := range children[e.JobID] {
+			walk(child, depth+1)
+		}
 	}
-	return v, nil
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	return out
 }
 
-func (v *GenerationMonitorView) View() string {
-	style := lipgloss.NewStyle().
-		Width(v.width).
-		Height(v.height).
-		Padding(2).
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(v.theme.Success)
-
-	content := lipgloss.NewStyle().
-		Foreground(v.theme.Success).
-		Bold(true).
-		Render("📊 Generation Monitor") + "\n\n" +
-		"Real-time progress tracking\n" +
-		"• Live progress bars and statistics\n" +
-		"• Record preview and validation\n" +
-		"• Performance metrics\n\n" +
-		lipgloss.NewStyle().
-			Foreground(v.theme.Secondary).
-			Render("Coming soon in the next implementation phase!")
-
-	return style.Render(content)
+// indent returns the "└─ "-prefixed padding for a history tree row at the
+// given branch depth (0 for a run started from scratch).
+func indent(depth int) string {
+	if depth == 0 {
+		return ""
+	}
+	pad := ""
+	for i := 1; i < depth; i++ {
+		pad += "  "
+	}
+	return pad + "└─ "
 }
 
 // SettingsView represents the settings panel
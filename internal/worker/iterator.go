@@ -0,0 +1,41 @@
+package worker
+
+import "sync"
+
+// Iterator hands out sequential Jobs covering target records in batches of
+// at most batchSize, until target is exhausted. Next is safe to call
+// concurrently from multiple Pool workers - each call claims a distinct,
+// non-overlapping slice of the remaining count.
+type Iterator struct {
+	mu        sync.Mutex
+	batchSize int
+	remaining int
+	nextBatch int
+}
+
+// NewIterator builds an Iterator that will yield batches of at most
+// batchSize records until target records have been claimed in total.
+func NewIterator(target, batchSize int) *Iterator {
+	return &Iterator{batchSize: batchSize, remaining: target}
+}
+
+// Next claims the next Job, or returns ok=false once every record has
+// already been claimed.
+func (it *Iterator) Next() (job Job, ok bool) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.remaining <= 0 {
+		return Job{}, false
+	}
+
+	size := it.batchSize
+	if size > it.remaining {
+		size = it.remaining
+	}
+
+	it.nextBatch++
+	it.remaining -= size
+
+	return Job{BatchNumber: it.nextBatch, Size: size}, true
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool runs Generate concurrently across Workers goroutines, each pulling
+// Jobs from an Iterator until it's exhausted or ctx is cancelled.
+type Pool struct {
+	// Workers is the number of concurrent generate calls in flight.
+	// Values < 1 are treated as 1 (sequential, same as before this
+	// package existed).
+	Workers int
+
+	// Generate produces the records for a single Job.
+	Generate GenerateFunc
+}
+
+// Run starts Workers goroutines pulling from it and returns a channel of
+// their Results. The channel is closed once it is exhausted and every
+// worker has returned. Results may arrive out of Job.BatchNumber order when
+// Workers > 1 - pass the channel through Reorder to restore it.
+func (p *Pool) Run(ctx context.Context, it *Iterator) <-chan Result {
+	workers := p.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make(chan Result)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		workerID := i
+		go func() {
+			defer wg.Done()
+			p.runWorker(ctx, workerID, it, results)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (p *Pool) runWorker(ctx context.Context, workerID int, it *Iterator, results chan<- Result) {
+	for {
+		job, ok := it.Next()
+		if !ok {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		records, err := p.Generate(ctx, job.Size)
+		result := Result{Job: job, WorkerID: workerID, Records: records, Err: err}
+
+		select {
+		case results <- result:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
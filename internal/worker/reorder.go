@@ -0,0 +1,36 @@
+package worker
+
+// Reorder consumes in (whose Results may arrive in any order when produced
+// by a multi-worker Pool) and emits them on the returned channel in
+// ascending Job.BatchNumber order, starting at 1. This lets a
+// single-writer consumer (internal/job.Controller) write records, advance
+// dedup state, and publish progress events in the same deterministic order
+// a sequential run would have, regardless of which worker finished first.
+// The returned channel is closed once in is closed. Results still waiting
+// behind a BatchNumber that never arrived (a worker that claimed a Job and
+// then stopped on ctx cancellation) are dropped, not emitted.
+func Reorder(in <-chan Result) <-chan Result {
+	out := make(chan Result)
+
+	go func() {
+		defer close(out)
+
+		pending := make(map[int]Result)
+		next := 1
+
+		for result := range in {
+			pending[result.Job.BatchNumber] = result
+			for {
+				r, ok := pending[next]
+				if !ok {
+					break
+				}
+				out <- r
+				delete(pending, next)
+				next++
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,43 @@
+// Package worker implements a bounded, concurrent batch-generation pool:
+// an Iterator hands out sequential batch requests, a Pool of goroutines
+// pulls from it and runs each through a caller-supplied GenerateFunc, and
+// Reorder restores ascending batch order on the result stream so a
+// single-writer consumer downstream (internal/job.Controller) sees batches
+// in the same order a sequential run would have produced them, regardless
+// of which worker finished first. This is what lets --workers/model.concurrency
+// overlap multiple in-flight requests against a high-latency cloud backend
+// without the output (or the deduplicator watching it) needing to become
+// concurrency-aware itself.
+package worker
+
+import (
+	"context"
+
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Job is a single batch generation request: produce Size records, numbered
+// BatchNumber in generation order (1-based, matching the sequential loop's
+// batchCount).
+type Job struct {
+	BatchNumber int
+	Size        int
+}
+
+// Result is what a Pool worker produces for a Job: the Records and Err its
+// GenerateFunc returned, so a failed batch can carry partial Records
+// alongside Err. WorkerID identifies which of Pool's goroutines produced it
+// (0-based), letting a consumer attribute a batch back to the worker that
+// generated it even after Reorder has resequenced the stream.
+type Result struct {
+	Job      Job
+	WorkerID int
+	Records  []types.Record
+	Err      error
+}
+
+// GenerateFunc generates count records for one batch. Implementations
+// should be safe to call concurrently from multiple Pool workers - the
+// Controller's GenerateFunc wraps llm.Client.GenerateWithConfig, which
+// already is.
+type GenerateFunc func(ctx context.Context, count int) ([]types.Record, error)
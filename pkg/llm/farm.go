@@ -0,0 +1,259 @@
+// Package llm provides Farm, a pool of Ollama nodes that spreads
+// generation across a bank of local GPUs instead of a single fixed
+// endpoint, in the spirit of ollamafarm. Unlike internal/llm, this package
+// holds no FauxFoundry-internal state, so it's importable from anywhere.
+package llm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	illm "github.com/copyleftdev/faux-foundry/internal/llm"
+	"github.com/copyleftdev/faux-foundry/pkg/log"
+	"github.com/copyleftdev/faux-foundry/pkg/types"
+)
+
+// Where filters which Farm nodes are eligible to serve a Generate call.
+// The zero value matches every healthy node.
+type Where struct {
+	// Group restricts eligibility to nodes registered under this Group.
+	// Empty matches any group.
+	Group string
+	// ModelName restricts eligibility to nodes that report this model
+	// loaded or installed. Empty matches regardless of model.
+	ModelName string
+	// MinPriority restricts eligibility to nodes with Priority >= this.
+	MinPriority int
+}
+
+// NodeStatus is the last health snapshot StartHealthChecks recorded for a
+// Node.
+type NodeStatus struct {
+	Healthy     bool
+	Models      []string
+	ActiveLoad  int
+	LastChecked time.Time
+	Err         error
+}
+
+// Node is a single Ollama endpoint registered with a Farm.
+type Node struct {
+	Endpoint string
+	Group    string
+	Priority int
+	Client   *illm.OllamaClient
+
+	mu     sync.RWMutex
+	status NodeStatus
+}
+
+// Status returns a copy of node's last recorded health snapshot.
+func (n *Node) Status() NodeStatus {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.status
+}
+
+func (n *Node) setStatus(status NodeStatus) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status = status
+}
+
+// matches reports whether node satisfies where's Group/ModelName/MinPriority
+// filters. It does not consider health; callers combine it with Status().
+func (n *Node) matches(where Where) bool {
+	if where.Group != "" && n.Group != where.Group {
+		return false
+	}
+	if n.Priority < where.MinPriority {
+		return false
+	}
+	if where.ModelName != "" {
+		status := n.Status()
+		found := false
+		for _, m := range status.Models {
+			if m == where.ModelName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Farm round-robins or least-loaded-dispatches Generate calls across a pool
+// of registered Ollama nodes, polling each one's health and current load so
+// a node that goes down is automatically skipped in favor of the rest.
+type Farm struct {
+	pollInterval time.Duration
+	logger       log.Logger
+
+	mu    sync.RWMutex
+	nodes []*Node
+
+	roundRobin uint64
+}
+
+// NewFarm builds a Farm that polls each registered node every pollInterval.
+// logger receives health-check and failover events; pass log.New(...) with
+// io.Discard to silence it.
+func NewFarm(pollInterval time.Duration, logger log.Logger) *Farm {
+	return &Farm{pollInterval: pollInterval, logger: logger}
+}
+
+// AddNode registers a new Ollama node at endpoint under group with the
+// given priority (higher is preferred when Where.MinPriority filters) and
+// returns it. The node starts out with an empty NodeStatus until the next
+// health check runs.
+func (f *Farm) AddNode(endpoint, group string, priority int) *Node {
+	node := &Node{
+		Endpoint: endpoint,
+		Group:    group,
+		Priority: priority,
+		Client:   illm.NewOllamaClient(),
+	}
+
+	f.mu.Lock()
+	f.nodes = append(f.nodes, node)
+	f.mu.Unlock()
+
+	return node
+}
+
+// Nodes returns every node registered with the farm, healthy or not.
+func (f *Farm) Nodes() []*Node {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	nodes := make([]*Node, len(f.nodes))
+	copy(nodes, f.nodes)
+	return nodes
+}
+
+// StartHealthChecks polls every registered node's /api/tags and /api/ps on
+// pollInterval until ctx is cancelled, refreshing the NodeStatus Where
+// filtering and dispatch read from. It blocks; run it in its own goroutine.
+func (f *Farm) StartHealthChecks(ctx context.Context) {
+	f.refreshAll(ctx)
+
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.refreshAll(ctx)
+		}
+	}
+}
+
+func (f *Farm) refreshAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, node := range f.Nodes() {
+		wg.Add(1)
+		go func(node *Node) {
+			defer wg.Done()
+			f.refreshNode(ctx, node)
+		}(node)
+	}
+	wg.Wait()
+}
+
+func (f *Farm) refreshNode(ctx context.Context, node *Node) {
+	health, err := node.Client.CheckOllamaHealth(ctx, node.Endpoint)
+	if err != nil || !health.IsRunning {
+		node.setStatus(NodeStatus{Healthy: false, LastChecked: time.Now(), Err: err})
+		f.logger.Warn("farm node unhealthy", "endpoint", node.Endpoint, "error", err)
+		return
+	}
+
+	running, err := node.Client.RunningModels(ctx, node.Endpoint)
+	if err != nil {
+		// /api/ps failing doesn't mean the node can't serve requests, just
+		// that we can't see its current load; treat it as idle rather than
+		// excluding an otherwise-healthy node.
+		f.logger.Warn("farm node load check failed", "endpoint", node.Endpoint, "error", err)
+	}
+
+	node.setStatus(NodeStatus{
+		Healthy:     true,
+		Models:      health.Models,
+		ActiveLoad:  len(running),
+		LastChecked: time.Now(),
+	})
+}
+
+// eligible returns every healthy node matching where, in a stable order.
+func (f *Farm) eligible(where Where) []*Node {
+	var nodes []*Node
+	for _, node := range f.Nodes() {
+		if node.Status().Healthy && node.matches(where) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// pick selects the least-loaded eligible node, breaking ties round-robin so
+// a tied set of idle nodes doesn't always send work to the first one.
+func (f *Farm) pick(where Where) (*Node, error) {
+	nodes := f.eligible(where)
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no healthy farm node matches group=%q model=%q min_priority=%d", where.Group, where.ModelName, where.MinPriority)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Status().ActiveLoad < nodes[j].Status().ActiveLoad
+	})
+
+	lowest := nodes[0].Status().ActiveLoad
+	tied := nodes
+	for i, node := range nodes {
+		if node.Status().ActiveLoad > lowest {
+			tied = nodes[:i]
+			break
+		}
+	}
+
+	offset := atomic.AddUint64(&f.roundRobin, 1)
+	return tied[offset%uint64(len(tied))], nil
+}
+
+// Generate dispatches a Generate call to the least-loaded node matching
+// where, retrying against the next eligible node on failure until every
+// eligible node has been tried.
+func (f *Farm) Generate(ctx context.Context, spec *types.Specification, count int, where Where) ([]types.Record, error) {
+	tried := map[string]bool{}
+
+	for {
+		node, err := f.pick(where)
+		if err != nil {
+			if len(tried) == 0 {
+				return nil, err
+			}
+			return nil, fmt.Errorf("all %d eligible farm node(s) failed", len(tried))
+		}
+		if tried[node.Endpoint] {
+			return nil, fmt.Errorf("all %d eligible farm node(s) failed", len(tried))
+		}
+		tried[node.Endpoint] = true
+
+		records, err := node.Client.Generate(ctx, spec, count)
+		if err == nil {
+			return records, nil
+		}
+
+		f.logger.Warn("farm node generate failed, failing over", "endpoint", node.Endpoint, "error", err)
+		node.setStatus(NodeStatus{Healthy: false, LastChecked: time.Now(), Err: err})
+	}
+}
@@ -0,0 +1,85 @@
+// Package log provides FauxFoundry's structured leveled logger, built on
+// log/slog, with console and JSON output. It replaces the emoji-decorated
+// fmt.Printf lines TimeoutHandler and the CLI used to emit: those can't be
+// parsed by a log aggregator, ignore --quiet, and carry no level at all.
+package log
+
+import (
+	"io"
+	"log/slog"
+)
+
+// Logger is the structured logging interface every component that used to
+// call fmt.Printf now logs through. It mirrors slog's leveled methods
+// rather than exposing *slog.Logger directly, so a caller can be handed a
+// fake in tests without depending on slog's concrete type.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+
+	// With returns a Logger that includes args on every subsequent call,
+	// the same way slog.Logger.With does.
+	With(args ...any) Logger
+}
+
+// Format selects how New encodes log records.
+type Format string
+
+const (
+	// FormatConsole writes slog's human-readable text handler output —
+	// the default, for a developer watching a terminal.
+	FormatConsole Format = "console"
+	// FormatJSON writes one JSON object per record, for a log aggregator
+	// like Loki.
+	FormatJSON Format = "json"
+)
+
+// New builds a Logger writing records at level or above to w, encoded per
+// format. An unrecognized format falls back to FormatConsole.
+func New(format Format, level slog.Level, w io.Writer) Logger {
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		handler = slog.NewTextHandler(w, opts)
+	}
+
+	return &slogLogger{logger: slog.New(handler)}
+}
+
+// LevelForQuiet resolves the effective log level from --quiet and
+// --log-level: --quiet always wins and maps to slog.LevelError, since
+// warnings and info are exactly the "non-essential output" it's meant to
+// suppress, regardless of what --log-level requested. An unparseable level
+// string falls back to slog.LevelInfo.
+func LevelForQuiet(quiet bool, level string) slog.Level {
+	if quiet {
+		return slog.LevelError
+	}
+
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return l
+}
+
+// slogLogger implements Logger by delegating to an underlying
+// *slog.Logger.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (s *slogLogger) Debug(msg string, args ...any) { s.logger.Debug(msg, args...) }
+func (s *slogLogger) Info(msg string, args ...any)  { s.logger.Info(msg, args...) }
+func (s *slogLogger) Warn(msg string, args ...any)  { s.logger.Warn(msg, args...) }
+func (s *slogLogger) Error(msg string, args ...any) { s.logger.Error(msg, args...) }
+
+func (s *slogLogger) With(args ...any) Logger {
+	return &slogLogger{logger: s.logger.With(args...)}
+}
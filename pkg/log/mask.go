@@ -0,0 +1,48 @@
+package log
+
+import (
+	"bytes"
+	"io"
+)
+
+// Masker wraps an io.Writer and redacts any registered secret substring
+// from bytes passed to Write, the same idea as GitHub Actions' `::add-mask::`
+// workflow command: once a secret is registered, nothing that writer ever
+// sees can leak it into a log file or terminal, even via an error message or
+// a struct's %+v dump that the caller didn't think to scrub.
+type Masker struct {
+	w       io.Writer
+	secrets [][]byte
+}
+
+// NewMasker returns a Masker writing to w with no secrets registered yet.
+func NewMasker(w io.Writer) *Masker {
+	return &Masker{w: w}
+}
+
+// AddMask registers secret for redaction in every future Write. Empty
+// strings are ignored — masking "" would redact nothing yet match every
+// position in the output.
+func (m *Masker) AddMask(secret string) {
+	if secret == "" {
+		return
+	}
+	m.secrets = append(m.secrets, []byte(secret))
+}
+
+// Write redacts every registered secret from p, replacing each occurrence
+// with "***", then writes the result to the underlying writer. It reports
+// len(p) rather than the underlying writer's return value: the caller gave
+// us len(p) bytes and none were dropped, only some were substituted, so
+// reporting anything else would make a correct write look like a short one.
+func (m *Masker) Write(p []byte) (int, error) {
+	out := p
+	for _, secret := range m.secrets {
+		out = bytes.ReplaceAll(out, secret, []byte("***"))
+	}
+
+	if _, err := m.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
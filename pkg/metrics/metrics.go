@@ -0,0 +1,135 @@
+// Package metrics exposes the Prometheus instrumentation for FauxFoundry's
+// generation pipeline: batch attempts, batch duration, records generated,
+// timeout-strategy transitions, and fallback usage. Metrics are bundled
+// into a Registry instead of registered against prometheus's global
+// DefaultRegisterer, so NewRegistry can be constructed fresh anywhere (a
+// CLI run, a future test) without colliding on duplicate registration.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Registry bundles every metric the generation pipeline records, plus the
+// prometheus.Registry they're registered against.
+type Registry struct {
+	reg *prometheus.Registry
+
+	// BatchAttemptsTotal counts generation batch attempts, labeled by the
+	// TimeoutStrategy applied ("direct" for a batch that needed none) and
+	// outcome ("success" or "failure").
+	BatchAttemptsTotal *prometheus.CounterVec
+
+	// BatchDurationSeconds observes how long a single generateBatch call
+	// takes, labeled by model.
+	BatchDurationSeconds *prometheus.HistogramVec
+
+	// RecordsGeneratedTotal counts records written, labeled by spec and
+	// dataset domain.
+	RecordsGeneratedTotal *prometheus.CounterVec
+
+	// TimeoutStrategyTransitionsTotal counts transitions between
+	// TimeoutHandler strategies, labeled by the from/to strategy names.
+	TimeoutStrategyTransitionsTotal *prometheus.CounterVec
+
+	// FallbackRecordsTotal counts records produced by
+	// generateFallbackData instead of the LLM.
+	FallbackRecordsTotal prometheus.Counter
+}
+
+// NewRegistry builds a Registry with every metric created and registered.
+func NewRegistry() *Registry {
+	reg := prometheus.NewRegistry()
+
+	r := &Registry{
+		reg: reg,
+		BatchAttemptsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "faux_foundry_batch_attempts_total",
+			Help: "Generation batch attempts, by timeout strategy and outcome.",
+		}, []string{"strategy", "outcome"}),
+		BatchDurationSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "faux_foundry_batch_duration_seconds",
+			Help:    "Wall-clock duration of a single generation batch call, by model.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"model"}),
+		RecordsGeneratedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "faux_foundry_records_generated_total",
+			Help: "Unique records written, by spec and dataset domain.",
+		}, []string{"spec", "domain"}),
+		TimeoutStrategyTransitionsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "faux_foundry_timeout_strategy_transitions_total",
+			Help: "Transitions between timeout-handling strategies, by from/to strategy name.",
+		}, []string{"from", "to"}),
+		FallbackRecordsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "faux_foundry_fallback_records_total",
+			Help: "Records produced by generateFallbackData instead of the LLM.",
+		}),
+	}
+
+	reg.MustRegister(
+		r.BatchAttemptsTotal,
+		r.BatchDurationSeconds,
+		r.RecordsGeneratedTotal,
+		r.TimeoutStrategyTransitionsTotal,
+		r.FallbackRecordsTotal,
+	)
+
+	return r
+}
+
+// Gatherer exposes the underlying prometheus.Registry for an HTTP /metrics
+// handler (promhttp.HandlerFor) or a Pushgateway push. A nil Registry
+// returns an empty one.
+func (r *Registry) Gatherer() prometheus.Gatherer {
+	if r == nil {
+		return prometheus.NewRegistry()
+	}
+	return r.reg
+}
+
+// ObserveBatchDuration records how long a generateBatch call for model
+// took. Callers typically defer this around the call with
+// time.Since(start).
+func (r *Registry) ObserveBatchDuration(model string, d time.Duration) {
+	if r == nil {
+		return
+	}
+	r.BatchDurationSeconds.WithLabelValues(model).Observe(d.Seconds())
+}
+
+// RecordBatchAttempt increments BatchAttemptsTotal for the given strategy
+// and outcome ("success" or "failure").
+func (r *Registry) RecordBatchAttempt(strategy, outcome string) {
+	if r == nil {
+		return
+	}
+	r.BatchAttemptsTotal.WithLabelValues(strategy, outcome).Inc()
+}
+
+// RecordRecordsGenerated increments RecordsGeneratedTotal by n for the
+// given spec and domain.
+func (r *Registry) RecordRecordsGenerated(specName, domain string, n int) {
+	if r == nil || n <= 0 {
+		return
+	}
+	r.RecordsGeneratedTotal.WithLabelValues(specName, domain).Add(float64(n))
+}
+
+// RecordStrategyTransition increments TimeoutStrategyTransitionsTotal for a
+// move from one strategy to another.
+func (r *Registry) RecordStrategyTransition(from, to string) {
+	if r == nil {
+		return
+	}
+	r.TimeoutStrategyTransitionsTotal.WithLabelValues(from, to).Inc()
+}
+
+// RecordFallbackRecords increments FallbackRecordsTotal by n.
+func (r *Registry) RecordFallbackRecords(n int) {
+	if r == nil || n <= 0 {
+		return
+	}
+	r.FallbackRecordsTotal.Add(float64(n))
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Tracer is the OpenTelemetry tracer every generation batch starts its span
+// from. It's a package-level var (rather than threaded through every call
+// site) because that's how the otel SDK itself is meant to be used: it
+// defers to whatever global TracerProvider InitTracing installs, and is a
+// safe no-op tracer before that ever runs.
+var Tracer = otel.Tracer("github.com/copyleftdev/faux-foundry/internal/llm")
+
+// InitTracing installs an OTLP/HTTP TracerProvider as the global one,
+// configured entirely from the standard OTEL_EXPORTER_OTLP_* environment
+// variables (OTEL_EXPORTER_OTLP_ENDPOINT, _PROTOCOL, _HEADERS, ...) that
+// otlptracehttp.New already reads. If OTEL_EXPORTER_OTLP_ENDPOINT is unset,
+// InitTracing does nothing and returns a no-op shutdown func, so a CLI run
+// with no collector configured doesn't block or error on startup.
+//
+// Call it once, early in the CLI's lifetime, and defer the returned
+// shutdown func so buffered spans flush before the process exits.
+func InitTracing(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	Tracer = tp.Tracer("github.com/copyleftdev/faux-foundry/internal/llm")
+
+	return tp.Shutdown, nil
+}
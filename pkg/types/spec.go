@@ -18,6 +18,25 @@ type ModelConfig struct {
 	BatchSize   int     `yaml:"batch_size" json:"batch_size"`
 	Temperature float64 `yaml:"temperature" json:"temperature"`
 	Timeout     string  `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// Provider selects which internal/llm/provider backend generates
+	// against this spec: "ollama" (the default when empty), "openai",
+	// "anthropic", "google", or "openai-compatible" (LM Studio,
+	// llama.cpp, vLLM, and other /v1/chat/completions-shaped servers).
+	Provider string `yaml:"provider,omitempty" json:"provider,omitempty"`
+
+	// APIKey authenticates against a hosted Provider. Leaving it empty
+	// falls back to the provider's conventional environment variable
+	// (e.g. OPENAI_API_KEY, ANTHROPIC_API_KEY, GOOGLE_API_KEY) so a spec
+	// committed to source control never has to contain a secret.
+	APIKey string `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+
+	// Concurrency is how many batches internal/job.Controller requests in
+	// parallel (see internal/worker). 0 or unset means sequential, the
+	// same behavior as before this field existed. --workers on `generate`
+	// overrides it. Raising this mainly helps hosted providers, where
+	// per-request latency - not local compute - is the bottleneck.
+	Concurrency int `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
 }
 
 // DatasetConfig defines the dataset generation parameters
@@ -25,6 +44,17 @@ type DatasetConfig struct {
 	Count  int     `yaml:"count" json:"count"`
 	Domain string  `yaml:"domain" json:"domain"`
 	Fields []Field `yaml:"fields" json:"fields"`
+
+	// Schema is an optional JSON Schema document (or a single OpenAPI 3
+	// components.schemas entry, which is already JSON-Schema-shaped) that
+	// constrains generated records. When set: spec.setDefaults derives
+	// Fields from it if Fields is empty, the Ollama request is built with
+	// "format": Schema so the model's output is structurally constrained,
+	// and each parsed record is validated against it directly instead of
+	// the required-field-count heuristic used when Schema is unset. It's a
+	// plain map (rather than json.RawMessage) so it round-trips through
+	// yaml.v3's native map decoding the same way Field.Default does.
+	Schema map[string]interface{} `yaml:"schema,omitempty" json:"schema,omitempty"`
 }
 
 // Field defines a single field in the generated dataset
@@ -37,6 +67,23 @@ type Field struct {
 	Range       []int       `yaml:"range,omitempty" json:"range,omitempty"`
 	Values      []string    `yaml:"values,omitempty" json:"values,omitempty"`
 	Default     interface{} `yaml:"default,omitempty" json:"default,omitempty"`
+
+	// Tools names one or more local tool calls (see internal/agents) that
+	// deterministically derive this field's value instead of leaving it to
+	// the model: e.g. drawing from reference data, sampling a pattern
+	// outside the prompt, or generating realistic fake data for a locale.
+	// When set, every generated record has this field overwritten with the
+	// tool's result after generation, regardless of what (if anything) the
+	// model produced for it.
+	Tools []Tool `yaml:"tools,omitempty" json:"tools,omitempty"`
+}
+
+// Tool is a single tool call a Field's Tools entry requests. Args is
+// loosely typed the same way Field.Default is: it decodes straight from
+// YAML/JSON, so numeric values arrive as float64.
+type Tool struct {
+	Name string                 `yaml:"name" json:"name"`
+	Args map[string]interface{} `yaml:"args,omitempty" json:"args,omitempty"`
 }
 
 // GenerationJob represents an active data generation job
@@ -49,6 +96,14 @@ type GenerationJob struct {
 	EndTime      *time.Time    `json:"end_time,omitempty"`
 	OutputPath   string        `json:"output_path"`
 	ErrorMessage string        `json:"error_message,omitempty"`
+
+	// ParentID is the job ID this run was branched from (see
+	// internal/history), empty for a run started from scratch.
+	ParentID string `json:"parent_id,omitempty"`
+	// SpecHash is a content hash of Spec (see internal/history.HashSpec),
+	// recorded alongside ParentID so two runs can be compared or
+	// deduplicated by what they actually generated from rather than by ID.
+	SpecHash string `json:"spec_hash,omitempty"`
 }
 
 // JobStatus represents the current status of a generation job
@@ -70,6 +125,11 @@ type Progress struct {
 	Rate         float64 `json:"rate"` // records per second
 	ElapsedTime  string  `json:"elapsed_time"`
 	EstimatedETA string  `json:"estimated_eta"`
+	// BatchSize is the record count requested for the in-flight (or most
+	// recently completed) batch, after the target-remaining clamp
+	// internal/job.Controller applies each iteration - distinct from
+	// Specification.Model.BatchSize, which is the configured ceiling.
+	BatchSize int `json:"batch_size"`
 }
 
 // Record represents a single generated data record